@@ -82,8 +82,10 @@ import (
 	"github.com/gorilla/mux"
 	gravityparams "github.com/peggyjv/gravity-bridge/module/v3/app/params"
 	v2 "github.com/peggyjv/gravity-bridge/module/v3/app/upgrades/v2"
+	v3 "github.com/peggyjv/gravity-bridge/module/v3/app/upgrades/v3"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity"
 	gravityclient "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client"
+	gravitydocs "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client/docs"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
 	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/rakyll/statik/fs"
@@ -128,6 +130,12 @@ var (
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
 			gravityclient.ProposalHandler,
+			gravityclient.SignerSetTxCreationProposalHandler,
+			gravityclient.RegisterCosmosDenomProposalHandler,
+			gravityclient.UpdateCosmosDenomERC20ProposalHandler,
+			gravityclient.CommunityPoolEthereumContractCallProposalHandler,
+			gravityclient.CommunityPoolEthereumMultiSpendProposalHandler,
+			gravityclient.CommunityPoolContractCallRefundProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -355,11 +363,25 @@ func NewGravityApp(
 		app.BaseApp,
 	)
 
+	// the gravity keeper must exist before the staking hooks are set, since
+	// staking only allows its hooks to be set once
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		app.accountKeeper,
+		&stakingKeeper,
+		app.bankKeeper,
+		app.slashingKeeper,
+		app.distrKeeper,
+		sdk.DefaultPowerReduction,
+	)
+
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
-			//app.gravityKeeper.Hooks(), TODO(bolten): this hook is broken, do not set it, to be fixed
+			app.gravityKeeper.Hooks(),
 		),
 	)
 
@@ -377,11 +399,12 @@ func NewGravityApp(
 		app.ibcKeeper.ChannelKeeper, app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
 		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
 	)
+	app.gravityKeeper.SetTransferKeeper(app.transferKeeper)
 	transferModule := ibctransfer.NewAppModule(app.transferKeeper)
 	transferIBCModule := ibctransfer.NewIBCModule(app.transferKeeper)
 
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, gravity.NewIBCMiddleware(transferIBCModule, app.gravityKeeper))
 	app.ibcKeeper.SetRouter(ibcRouter)
 
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -392,20 +415,6 @@ func NewGravityApp(
 	)
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		app.accountKeeper,
-		stakingKeeper,
-		app.bankKeeper,
-		app.slashingKeeper,
-		app.distrKeeper,
-		sdk.DefaultPowerReduction,
-		app.ModuleAccountAddressesToNames([]string{}),
-		app.ModuleAccountAddressesToNames([]string{distrtypes.ModuleName}),
-	)
-
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
@@ -662,16 +671,6 @@ func (app *Gravity) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
-// ModuleAccountNames returns a map of module account address to module name
-func (app *Gravity) ModuleAccountAddressesToNames(moduleAccounts []string) map[string]string {
-	modAccNames := make(map[string]string)
-	for _, acc := range moduleAccounts {
-		modAccNames[authtypes.NewModuleAddress(acc).String()] = acc
-	}
-
-	return modAccNames
-}
-
 // BlockedAddrs returns all the app's module account addresses that are not
 // allowed to receive external tokens.
 func (app *Gravity) BlockedAddrs() map[string]bool {
@@ -747,20 +746,25 @@ func (app *Gravity) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
-	// TODO: build the custom gravity swagger files and add here?
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
 }
 
-// RegisterSwaggerAPI registers swagger route with API Server
-// TODO: build the custom gravity swagger files and add here?
+// RegisterSwaggerAPI registers swagger route with API Server. The gravity
+// Query service document is served next to the SDK's swagger UI, and can be
+// loaded in it from /swagger/gravity/query.swagger.json
 func RegisterSwaggerAPI(ctx client.Context, rtr *mux.Router) {
 	statikFS, err := fs.New()
 	if err != nil {
 		panic(err)
 	}
 
+	rtr.HandleFunc("/swagger/gravity/query.swagger.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(gravitydocs.QuerySwagger)
+	})
+
 	staticServer := http.FileServer(statikFS)
 	rtr.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
 }
@@ -840,4 +844,12 @@ func (app *Gravity) setupUpgradeHandlers() {
 			app.bankKeeper,
 		),
 	)
+
+	app.upgradeKeeper.SetUpgradeHandler(
+		v3.UpgradeName,
+		v3.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+		),
+	)
 }
@@ -0,0 +1,15 @@
+#!/usr/bin/env bash
+
+set -eo pipefail
+
+# generates the OpenAPI document of the gravity Query service, which the API
+# server embeds and serves at /swagger/gravity/query.swagger.json
+mkdir -p ./tmp-swagger-gen
+buf protoc \
+  -I "proto" \
+  -I "third_party/proto" \
+  --swagger_out=logtostderr=true:./tmp-swagger-gen \
+  proto/gravity/v1/query.proto
+
+cp ./tmp-swagger-gen/gravity/v1/query.swagger.json ./x/gravity/client/docs/
+rm -rf ./tmp-swagger-gen
@@ -11,12 +11,12 @@ for dir in $proto_dirs; do
 Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
   $(find "${dir}" -maxdepth 1 -name '*.proto')
 
-  # # command to generate gRPC gateway (*.pb.gw.go in respective modules) files
-  # buf protoc \
-  # -I "proto" \
-  # -I "third_party/proto" \
-  # --grpc-gateway_out=logtostderr=true:. \
-  # $(find "${dir}" -maxdepth 1 -name '*.proto')
+  # command to generate gRPC gateway (*.pb.gw.go in respective modules) files
+  buf protoc \
+  -I "proto" \
+  -I "third_party/proto" \
+  --grpc-gateway_out=logtostderr=true:. \
+  $(find "${dir}" -maxdepth 1 -name '*.proto')
 
 done
 
@@ -22,6 +22,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	createSignerSetTxs(ctx, k)
 	createBatchTxs(ctx, k)
 	pruneSignerSetTxs(ctx, k)
+	pruneExecutedOutgoingTxs(ctx, k)
 }
 
 // EndBlocker is called at the end of every block
@@ -59,24 +60,33 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
+	// 3. If power change between validators of Current signer set and latest signer set request is above
+	//      the SignerSetTxPowerChangeThreshold param
+	// 4. If MaxSignerSetTxBlockInterval is set and at least that many blocks have passed since the latest
+	//      signer set request
 	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
 	if latestSignerSetTx == nil {
 		k.CreateSignerSetTx(ctx)
 		return
 	}
 
+	params := k.GetParams(ctx)
 	lastUnbondingHeight := k.GetLastUnbondingBlockHeight(ctx)
 	blockHeight := uint64(ctx.BlockHeight())
 	powerDiff := types.EthereumSigners(k.CurrentSignerSet(ctx)).PowerDiff(latestSignerSetTx.Signers)
+	intervalElapsed := params.MaxSignerSetTxBlockInterval > 0 &&
+		blockHeight >= latestSignerSetTx.Height+params.MaxSignerSetTxBlockInterval
 
-	shouldCreate := (lastUnbondingHeight == blockHeight) || (powerDiff > 0.05)
+	shouldCreate := (lastUnbondingHeight == blockHeight) ||
+		(powerDiff > params.SignerSetTxPowerChangeThreshold.MustFloat64()) ||
+		intervalElapsed
 	k.Logger(ctx).Info(
 		"considering signer set tx creation",
 		"blockHeight", blockHeight,
 		"lastUnbondingHeight", lastUnbondingHeight,
 		"latestSignerSetTx.Nonce", latestSignerSetTx.Nonce,
 		"powerDiff", powerDiff,
+		"intervalElapsed", intervalElapsed,
 		"shouldCreate", shouldCreate,
 	)
 
@@ -106,6 +116,17 @@ func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
+// pruneExecutedOutgoingTxs removes the records of outgoing txs executed more
+// than the executed tx history window ago, a zero window keeps them forever
+func pruneExecutedOutgoingTxs(ctx sdk.Context, k keeper.Keeper) {
+	window := k.GetParams(ctx).ExecutedTxHistoryWindow
+	currentBlock := uint64(ctx.BlockHeight())
+	if window == 0 || currentBlock <= window {
+		return
+	}
+	k.PruneExecutedOutgoingTxs(ctx, currentBlock-window)
+}
+
 // Iterate over all attestations currently being voted on in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
@@ -247,7 +268,7 @@ func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 	})
 }
 
-// cleanupTimedOutContractCallTxs deletes logic calls that have passed their expiration on Ethereum
+// cleanupTimedOutContractCallTxs cancels logic calls that have passed their expiration on Ethereum
 // keep in mind several things when modifying this function
 // A) unlike nonces timeouts are not monotonically increasing, meaning call 5 can have a later timeout than batch 6
 //    this means that we MUST only cleanup a single call at a time
@@ -261,7 +282,9 @@ func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
 	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		cctx, _ := otx.(*types.ContractCallTx)
 		if cctx.Timeout < ethereumHeight {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+			if err := k.CancelContractCallTx(ctx, cctx); err != nil {
+				k.HoldContractCallRefund(ctx, cctx, err)
+			}
 		}
 		return true
 	})
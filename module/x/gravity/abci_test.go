@@ -227,6 +227,60 @@ func TestSignerSetTxEmission(t *testing.T) {
 	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
 }
 
+func TestSignerSetTxEmission_PowerChangeThreshold(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+
+	params := gravityKeeper.GetParams(ctx)
+	params.SignerSetTxPowerChangeThreshold = sdk.NewDecWithPrec(20, 2)
+	input.SetGravityParams(ctx, params)
+
+	// Store a validator set with a 10% power change as the most recent validator set
+	sstx := gravityKeeper.CreateSignerSetTx(ctx)
+	delta := float64(types.EthereumSigners(sstx.Signers).TotalPower()) * 0.1
+	sstx.Signers[0].Power = uint64(float64(sstx.Signers[0].Power) - delta/2)
+	sstx.Signers[1].Power = uint64(float64(sstx.Signers[1].Power) + delta/2)
+	gravityKeeper.SetOutgoingTx(ctx, sstx)
+
+	// the power change is below the threshold, no new signer set tx
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 1, len(gravityKeeper.GetSignerSetTxs(ctx)))
+
+	// lowering the threshold below the power change creates one
+	params.SignerSetTxPowerChangeThreshold = sdk.NewDecWithPrec(5, 2)
+	input.SetGravityParams(ctx, params)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(2)))
+	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
+}
+
+func TestSignerSetTxEmission_MaxBlockInterval(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+
+	params := gravityKeeper.GetParams(ctx)
+	params.MaxSignerSetTxBlockInterval = 100
+	input.SetGravityParams(ctx, params)
+
+	sstx := gravityKeeper.CreateSignerSetTx(ctx)
+
+	// no power change and the interval has not yet elapsed
+	ctx = ctx.WithBlockHeight(int64(sstx.Height + 99))
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 1, len(gravityKeeper.GetSignerSetTxs(ctx)))
+
+	// the interval has elapsed, a new signer set tx is created regardless
+	ctx = ctx.WithBlockHeight(int64(sstx.Height + 100))
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(2)))
+	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
+
+	// the interval is measured from the latest signer set tx
+	ctx = ctx.WithBlockHeight(int64(sstx.Height + 150))
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
+}
+
 func TestSignerSetTxSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gk := input.GravityKeeper
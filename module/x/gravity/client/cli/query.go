@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -46,6 +48,14 @@ func GetQueryCmd() *cobra.Command {
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
 		CmdLastObservedEthereumHeight(),
+		CmdSignerSetCoverage(),
+		CmdOutgoingTxSignedPower(),
+		CmdOutgoingTxSignatureStatus(),
+		CmdOutgoingTxRelayCalldata(),
+		CmdExecutedOutgoingTxsByToken(),
+		CmdExecutedOutgoingTxsBySender(),
+		CmdBridgedToken(),
+		CmdBridgedTokens(),
 	)
 
 	return gravityQueryCmd
@@ -830,6 +840,252 @@ func CmdLastObservedEthereumHeight() *cobra.Command {
 	return cmd
 }
 
+func CmdSignerSetCoverage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-coverage",
+		Args:  cobra.NoArgs,
+		Short: "query the fraction of bonded power represented in the signer set and the validators missing delegate keys",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetCoverage(cmd.Context(), &types.SignerSetCoverageRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdOutgoingTxSignedPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-tx-signed-power [store-index]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the signed power, missing signers and relay readiness of an outgoing tx against the last observed Ethereum signer set, by hex encoded store index",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			storeIndex, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid store index %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.OutgoingTxSignedPower(cmd.Context(), &types.OutgoingTxSignedPowerRequest{StoreIndex: storeIndex})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdOutgoingTxSignatureStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-tx-signature-status [store-index]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the signed power, missing signers and relay readiness of an outgoing tx, by hex encoded store index",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			storeIndex, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid store index %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.OutgoingTxSignatureStatus(cmd.Context(), &types.OutgoingTxSignatureStatusRequest{StoreIndex: storeIndex})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdOutgoingTxRelayCalldata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-tx-relay-calldata [store-index]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the ABI encoded Gravity contract calldata that relays an outgoing tx, by hex encoded store index",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			storeIndex, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid store index %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.OutgoingTxRelayCalldata(cmd.Context(), &types.OutgoingTxRelayCalldataRequest{StoreIndex: storeIndex})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdExecutedOutgoingTxsByToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executed-outgoing-txs-by-token [token-contract]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the records of executed batches and contract calls that moved a token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutedOutgoingTxsByToken(cmd.Context(), &types.ExecutedOutgoingTxsByTokenRequest{
+				TokenContract: tokenContract,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executed-outgoing-txs-by-token")
+	return cmd
+}
+
+func CmdExecutedOutgoingTxsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executed-outgoing-txs-by-sender [sender-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the records of executed batches that included a send to ethereum from a sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutedOutgoingTxsBySender(cmd.Context(), &types.ExecutedOutgoingTxsBySenderRequest{
+				SenderAddress: sender.String(),
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executed-outgoing-txs-by-sender")
+	return cmd
+}
+
+func CmdBridgedToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridged-token [denom-or-erc20]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the bridge details of a token by denom or by ERC20 contract address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.BridgedTokenRequest{Denom: args[0]}
+			if common.IsHexAddress(args[0]) {
+				req = &types.BridgedTokenRequest{Erc20: args[0]}
+			}
+
+			res, err := queryClient.BridgedToken(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgedTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridged-tokens",
+		Args:  cobra.NoArgs,
+		Short: "query the bridge details of all Cosmos originated tokens with a deployed ERC20 and all Ethereum originated tokens with a voucher supply",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgedTokens(cmd.Context(), &types.BridgedTokensRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bridged-tokens")
+	return cmd
+}
+
 func newContextAndQueryClient(cmd *cobra.Command) (client.Context, types.QueryClient, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
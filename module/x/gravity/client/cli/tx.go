@@ -32,6 +32,7 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdCancelSendToEthereum(),
 		CmdRequestBatchTx(),
 		CmdSetDelegateKeys(),
+		CmdRotateDelegateKeys(),
 	)
 
 	return gravityTxCmd
@@ -192,6 +193,53 @@ the validator's address and operator account current nonce.`,
 	return cmd
 }
 
+func CmdRotateDelegateKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-delegate-keys [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Rotate gravity delegate keys",
+		Long: `Replace a validator's Ethereum and/or orchestrator addresses. An address that
+is not being rotated is passed unchanged. As for set-delegate-keys, the resulting
+Ethereum key must sign over a binary Proto-encoded DelegateKeysSignMsg message.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			ethAddr, err := parseContractAddress(args[2])
+			if err != nil {
+				return err
+			}
+
+			ethSig, err := hexutil.Decode(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSubmitCommunityPoolEthereumSpendProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "community-pool-ethereum-spend [proposal-file]",
@@ -278,3 +326,469 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+func CmdSubmitCommunityPoolEthereumMultiSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-ethereum-multi-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool Ethereum spend proposal to several recipients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool Ethereum multi spend proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The funds from the community pool will be
+bridged to Ethereum to each of the supplied recipient Ethereum addresses. Every spend may use a
+different denomination, but the bridge fee of a spend must be of the same denomination as its
+amount. If the community pool can't cover all spends, none of them are made.
+
+Example:
+$ %s tx gov submit-proposal community-pool-ethereum-multi-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Community Pool Ethereum Grants",
+	"description": "Bridge grants to Ethereum!",
+	"spends": [
+		{
+			"recipient": "0x0000000000000000000000000000000000000001",
+			"amount": "20000stake",
+			"bridge_fee": "1000stake"
+		},
+		{
+			"recipient": "0x0000000000000000000000000000000000000002",
+			"amount": "5000stake",
+			"bridge_fee": "1000stake"
+		}
+	],
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseCommunityPoolEthereumMultiSpendProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			if len(proposal.Spends) == 0 {
+				return fmt.Errorf("spends are empty")
+			}
+
+			spends := make([]types.CommunityPoolEthereumSpend, len(proposal.Spends))
+			for i, spend := range proposal.Spends {
+				if !common.IsHexAddress(spend.Recipient) {
+					return fmt.Errorf("recipient of spend %d is not a valid Ethereum address", i)
+				}
+
+				amount, err := sdk.ParseCoinNormalized(spend.Amount)
+				if err != nil {
+					return err
+				}
+
+				bridgeFee, err := sdk.ParseCoinNormalized(spend.BridgeFee)
+				if err != nil {
+					return err
+				}
+
+				if amount.Denom != bridgeFee.Denom {
+					return fmt.Errorf("amount and bridge fee denominations of spend %d must match", i)
+				}
+
+				spends[i] = types.NewCommunityPoolEthereumSpend(spend.Recipient, amount, bridgeFee)
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewCommunityPoolEthereumMultiSpendProposal(proposal.Title, proposal.Description, spends)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitCommunityPoolEthereumContractCallProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-ethereum-contract-call [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool Ethereum contract call proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool Ethereum contract call proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The tokens from the community pool will be
+bridged to Ethereum and sent to the supplied contract address, which is then called with the hex
+encoded payload. The fees are paid to the relayer of the call. If the call times out on Ethereum,
+the tokens and fees are returned to the community pool.
+
+Example:
+$ %s tx gov submit-proposal community-pool-ethereum-contract-call <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Community Pool Vault Deposit",
+	"description": "Deposit community pool tokens into a vault on Ethereum",
+	"address": "0x0000000000000000000000000000000000000000",
+	"payload": "0xb6b55f250000000000000000000000000000000000000000000000000000000000004e20",
+	"tokens": "20000stake",
+	"fees": "1000stake",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseCommunityPoolEthereumContractCallProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			if !common.IsHexAddress(proposal.Address) {
+				return fmt.Errorf("address is not a valid Ethereum address")
+			}
+
+			payload, err := hexutil.Decode(proposal.Payload)
+			if err != nil {
+				return err
+			}
+
+			tokens, err := sdk.ParseCoinsNormalized(proposal.Tokens)
+			if err != nil {
+				return err
+			}
+
+			fees, err := sdk.ParseCoinsNormalized(proposal.Fees)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewCommunityPoolEthereumContractCallProposal(proposal.Title, proposal.Description, proposal.Address, payload, tokens, fees)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitCommunityPoolContractCallRefundProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-contract-call-refund [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to retry the refund of a community pool contract call",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool contract call refund proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. When a community pool contract call is
+canceled but its tokens and fees cannot be returned to the community pool, they stay escrowed
+in the gravity module and the call is held under its invalidation nonce. If the proposal passes,
+the refund is retried.
+
+Example:
+$ %s tx gov submit-proposal community-pool-contract-call-refund <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Community Pool Contract Call Refund",
+	"description": "Return the tokens of the timed out contract call to the community pool",
+	"invalidation_nonce": "3",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseCommunityPoolContractCallRefundProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewCommunityPoolContractCallRefundProposal(proposal.Title, proposal.Description, proposal.InvalidationNonce)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitSignerSetTxCreationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-tx-creation [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to force the creation of a new signer set tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a signer set tx creation proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. If the proposal passes, a new signer
+set tx is created from the current validator set, regardless of how much the validator powers
+have changed since the latest signer set tx.
+
+Example:
+$ %s tx gov submit-proposal signer-set-tx-creation <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Signer Set Tx Creation",
+	"description": "Update the signer set on Ethereum now",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSignerSetTxCreationProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewSignerSetTxCreationProposal(proposal.Title, proposal.Description)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitRegisterCosmosDenomProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-cosmos-denom [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to approve the deployment of an ERC20 for a Cosmos originated denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a register cosmos denom proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. ERC20 deployed events are only accepted
+for denoms registered by governance. If erc20_name is set, the deployed ERC20 must also have
+exactly the given name, symbol and decimals. An optional decimal_shift lets the ERC20 have more
+(or, when negative, fewer) decimals than the denom, amounts are scaled between them on transfer.
+
+Example:
+$ %s tx gov submit-proposal register-cosmos-denom <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Register Atom",
+	"description": "Allow an ERC20 to be deployed for uatom",
+	"denom": "uatom",
+	"erc20_name": "Atom",
+	"erc20_symbol": "ATOM",
+	"erc20_decimals": 6,
+	"decimal_shift": 0,
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseRegisterCosmosDenomProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewRegisterCosmosDenomProposal(proposal.Title, proposal.Description, proposal.Denom, proposal.Erc20Name, proposal.Erc20Symbol, proposal.Erc20Decimals, proposal.DecimalShift)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitUpdateCosmosDenomERC20Proposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-cosmos-denom-erc20 [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to replace or remove the ERC20 a Cosmos originated denom is mapped to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update cosmos denom ERC20 proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. If the proposal passes, the denom is mapped
+to the given ERC20, or its mapping is removed if erc20 is empty. The decimal shift is the number
+of decimals the new ERC20 has beyond the denom. The proposal fails while any transfers of the
+currently mapped ERC20 are pending, or if vouchers of the new ERC20 exist.
+
+Example:
+$ %s tx gov submit-proposal update-cosmos-denom-erc20 <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Re-map Atom",
+	"description": "Replace the ERC20 deployed with bad metadata for uatom",
+	"denom": "uatom",
+	"erc20": "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+	"decimal_shift": 12,
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseUpdateCosmosDenomERC20Proposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewUpdateCosmosDenomERC20Proposal(proposal.Title, proposal.Description, proposal.Denom, proposal.Erc20, proposal.DecimalShift)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -32,3 +32,38 @@ func TestParseCommunityPoolEthereumSpendProposal(t *testing.T) {
 	require.Equal(t, "1000stake", proposal.BridgeFee)
 	require.Equal(t, "1000stake", proposal.Deposit)
 }
+
+func TestParseCommunityPoolEthereumMultiSpendProposal(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Ethereum Grants",
+  "description": "Bridge grants to Ethereum!",
+  "spends": [
+    {
+      "recipient": "0x0000000000000000000000000000000000000001",
+      "amount": "20000stake",
+      "bridge_fee": "1000stake"
+    },
+    {
+      "recipient": "0x0000000000000000000000000000000000000002",
+      "amount": "5000stake",
+      "bridge_fee": "500stake"
+    }
+  ],
+  "deposit": "1000stake"
+}
+`)
+
+	proposal, err := ParseCommunityPoolEthereumMultiSpendProposal(encodingConfig.Marshaler, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Ethereum Grants", proposal.Title)
+	require.Equal(t, "Bridge grants to Ethereum!", proposal.Description)
+	require.Len(t, proposal.Spends, 2)
+	require.Equal(t, "0x0000000000000000000000000000000000000002", proposal.Spends[1].Recipient)
+	require.Equal(t, "5000stake", proposal.Spends[1].Amount)
+	require.Equal(t, "500stake", proposal.Spends[1].BridgeFee)
+	require.Equal(t, "1000stake", proposal.Deposit)
+}
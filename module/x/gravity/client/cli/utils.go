@@ -22,3 +22,99 @@ func ParseCommunityPoolEthereumSpendProposal(cdc codec.JSONCodec, proposalFile s
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolEthereumMultiSpendProposal reads and parses a CommunityPoolEthereumMultiSpendProposalForCLI from a file.
+func ParseCommunityPoolEthereumMultiSpendProposal(cdc codec.JSONCodec, proposalFile string) (types.CommunityPoolEthereumMultiSpendProposalForCLI, error) {
+	proposal := types.CommunityPoolEthereumMultiSpendProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCommunityPoolEthereumContractCallProposal reads and parses a CommunityPoolEthereumContractCallProposalForCLI from a file.
+func ParseCommunityPoolEthereumContractCallProposal(cdc codec.JSONCodec, proposalFile string) (types.CommunityPoolEthereumContractCallProposalForCLI, error) {
+	proposal := types.CommunityPoolEthereumContractCallProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCommunityPoolContractCallRefundProposal reads and parses a CommunityPoolContractCallRefundProposalForCLI from a file.
+func ParseCommunityPoolContractCallRefundProposal(cdc codec.JSONCodec, proposalFile string) (types.CommunityPoolContractCallRefundProposalForCLI, error) {
+	proposal := types.CommunityPoolContractCallRefundProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseSignerSetTxCreationProposal reads and parses a SignerSetTxCreationProposalForCLI from a file.
+func ParseSignerSetTxCreationProposal(cdc codec.JSONCodec, proposalFile string) (types.SignerSetTxCreationProposalForCLI, error) {
+	proposal := types.SignerSetTxCreationProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseRegisterCosmosDenomProposal reads and parses a RegisterCosmosDenomProposalForCLI from a file.
+func ParseRegisterCosmosDenomProposal(cdc codec.JSONCodec, proposalFile string) (types.RegisterCosmosDenomProposalForCLI, error) {
+	proposal := types.RegisterCosmosDenomProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdateCosmosDenomERC20Proposal reads and parses a UpdateCosmosDenomERC20ProposalForCLI from a file.
+func ParseUpdateCosmosDenomERC20Proposal(cdc codec.JSONCodec, proposalFile string) (types.UpdateCosmosDenomERC20ProposalForCLI, error) {
+	proposal := types.UpdateCosmosDenomERC20ProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,12 @@
+package docs
+
+import (
+	_ "embed"
+)
+
+// QuerySwagger is the OpenAPI document of the gravity Query service gRPC
+// gateway routes, generated from query.proto by
+// contrib/local/protoc-swagger-gen.sh
+//
+//go:embed query.swagger.json
+var QuerySwagger []byte
@@ -6,7 +6,25 @@ import (
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client/rest"
 )
 
-// ProposalHandler is the community Ethereum spend proposal handler.
 var (
+	// ProposalHandler is the community Ethereum spend proposal handler.
 	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal, rest.ProposalRESTHandler)
+
+	// CommunityPoolEthereumMultiSpendProposalHandler is the community pool Ethereum multi spend proposal handler.
+	CommunityPoolEthereumMultiSpendProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumMultiSpendProposal, rest.CommunityPoolEthereumMultiSpendProposalRESTHandler)
+
+	// CommunityPoolEthereumContractCallProposalHandler is the community pool Ethereum contract call proposal handler.
+	CommunityPoolEthereumContractCallProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumContractCallProposal, rest.CommunityPoolEthereumContractCallProposalRESTHandler)
+
+	// CommunityPoolContractCallRefundProposalHandler is the community pool contract call refund proposal handler.
+	CommunityPoolContractCallRefundProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolContractCallRefundProposal, rest.CommunityPoolContractCallRefundProposalRESTHandler)
+
+	// SignerSetTxCreationProposalHandler is the signer set tx creation proposal handler.
+	SignerSetTxCreationProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSignerSetTxCreationProposal, rest.SignerSetTxCreationProposalRESTHandler)
+
+	// RegisterCosmosDenomProposalHandler is the register cosmos denom proposal handler.
+	RegisterCosmosDenomProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitRegisterCosmosDenomProposal, rest.RegisterCosmosDenomProposalRESTHandler)
+
+	// UpdateCosmosDenomERC20ProposalHandler is the update cosmos denom ERC20 proposal handler.
+	UpdateCosmosDenomERC20ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitUpdateCosmosDenomERC20Proposal, rest.UpdateCosmosDenomERC20ProposalRESTHandler)
 )
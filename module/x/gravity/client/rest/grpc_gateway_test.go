@@ -0,0 +1,133 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/app"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func newNetworkConfig() network.Config {
+	encCfg := app.MakeEncodingConfig()
+
+	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
+	cfg.GenesisState = app.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+	cfg.NumValidators = 1
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return app.NewGravityApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encCfg,
+			simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+
+	return cfg
+}
+
+func TestGRPCGatewayRoutes(t *testing.T) {
+	net := network.New(t, newNetworkConfig())
+	defer net.Cleanup()
+
+	_, err := net.WaitForHeight(2)
+	require.NoError(t, err)
+
+	val := net.Validators[0]
+	baseURL := val.APIAddress
+
+	t.Run("params", func(t *testing.T) {
+		resp, err := rest.GetRequest(fmt.Sprintf("%s/gravity/v1/params", baseURL))
+		require.NoError(t, err)
+
+		var res types.ParamsResponse
+		require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(resp, &res))
+		require.Equal(t, types.DefaultParams().GravityId, res.Params.GravityId)
+	})
+
+	t.Run("signer set txs", func(t *testing.T) {
+		resp, err := rest.GetRequest(fmt.Sprintf("%s/gravity/v1/signer_sets", baseURL))
+		require.NoError(t, err)
+
+		var res types.SignerSetTxsResponse
+		require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(resp, &res))
+		require.NotNil(t, res.Pagination)
+	})
+
+	t.Run("batch txs", func(t *testing.T) {
+		resp, err := rest.GetRequest(fmt.Sprintf("%s/gravity/v1/batch/batch_txs", baseURL))
+		require.NoError(t, err)
+
+		var res types.BatchTxsResponse
+		require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(resp, &res))
+		require.Empty(t, res.Batches)
+	})
+
+	t.Run("delegate keys by validator", func(t *testing.T) {
+		resp, err := rest.GetRequest(fmt.Sprintf("%s/gravity/v1/delegate_keys/validator/%s", baseURL, val.ValAddress))
+		require.NoError(t, err)
+
+		var res types.DelegateKeysByValidatorResponse
+		require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(resp, &res))
+	})
+
+	t.Run("last observed ethereum height", func(t *testing.T) {
+		resp, err := rest.GetRequest(fmt.Sprintf("%s/gravity/v1/last_observed_ethereum_height", baseURL))
+		require.NoError(t, err)
+
+		var res types.LastObservedEthereumHeightResponse
+		require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(resp, &res))
+		require.NotNil(t, res.LastObservedEthereumHeight)
+	})
+
+	t.Run("outgoing tx not found", func(t *testing.T) {
+		resp, err := rest.GetRequest(fmt.Sprintf("%s/gravity/v1/outgoing_tx_signed_power/AQI=", baseURL))
+		require.NoError(t, err)
+
+		var res struct {
+			Code    codes.Code `json:"code"`
+			Message string     `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal(resp, &res))
+		require.Equal(t, codes.NotFound, res.Code)
+		require.Contains(t, res.Message, "no outgoing tx found for 0102")
+	})
+}
+
+func TestRegisterSwaggerAPI(t *testing.T) {
+	rtr := mux.NewRouter()
+	app.RegisterSwaggerAPI(client.Context{}, rtr)
+
+	rec := httptest.NewRecorder()
+	rtr.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/swagger/gravity/query.swagger.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res struct {
+		Paths map[string]interface{} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Contains(t, res.Paths, "/gravity/v1/params")
+	require.Contains(t, res.Paths, "/gravity/v1/outgoing_tx_signed_power/{store_index}")
+	require.Contains(t, res.Paths, "/gravity/v1/outgoing_tx_signature_status/{store_index}")
+}
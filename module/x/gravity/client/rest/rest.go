@@ -44,3 +44,207 @@ func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// CommunityPoolEthereumMultiSpendProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool Ethereum multi spend REST handler with a given sub-route.
+func CommunityPoolEthereumMultiSpendProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_ethereum_multi_spend",
+		Handler:  postCommunityPoolEthereumMultiSpendProposalHandlerFn(clientCtx),
+	}
+}
+
+func postCommunityPoolEthereumMultiSpendProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolEthereumMultiSpendProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolEthereumMultiSpendProposal(req.Title, req.Description, req.Spends)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// CommunityPoolEthereumContractCallProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool Ethereum contract call REST handler with a given sub-route.
+func CommunityPoolEthereumContractCallProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_ethereum_contract_call",
+		Handler:  postCommunityPoolEthereumContractCallProposalHandlerFn(clientCtx),
+	}
+}
+
+func postCommunityPoolEthereumContractCallProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolEthereumContractCallProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolEthereumContractCallProposal(req.Title, req.Description, req.Address, req.Payload, req.Tokens, req.Fees)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// CommunityPoolContractCallRefundProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool contract call refund REST handler with a given sub-route.
+func CommunityPoolContractCallRefundProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_contract_call_refund",
+		Handler:  postCommunityPoolContractCallRefundProposalHandlerFn(clientCtx),
+	}
+}
+
+func postCommunityPoolContractCallRefundProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolContractCallRefundProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolContractCallRefundProposal(req.Title, req.Description, req.InvalidationNonce)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// SignerSetTxCreationProposalRESTHandler returns a ProposalRESTHandler that exposes the signer set tx creation REST handler with a given sub-route.
+func SignerSetTxCreationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "signer_set_tx_creation",
+		Handler:  postSignerSetTxCreationProposalHandlerFn(clientCtx),
+	}
+}
+
+func postSignerSetTxCreationProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SignerSetTxCreationProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSignerSetTxCreationProposal(req.Title, req.Description)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// RegisterCosmosDenomProposalRESTHandler returns a ProposalRESTHandler that exposes the register cosmos denom REST handler with a given sub-route.
+func RegisterCosmosDenomProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_cosmos_denom",
+		Handler:  postRegisterCosmosDenomProposalHandlerFn(clientCtx),
+	}
+}
+
+func postRegisterCosmosDenomProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RegisterCosmosDenomProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewRegisterCosmosDenomProposal(req.Title, req.Description, req.Denom, req.Erc20Name, req.Erc20Symbol, req.Erc20Decimals, req.DecimalShift)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// UpdateCosmosDenomERC20ProposalRESTHandler returns a ProposalRESTHandler that exposes the update cosmos denom ERC20 REST handler with a given sub-route.
+func UpdateCosmosDenomERC20ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_cosmos_denom_erc20",
+		Handler:  postUpdateCosmosDenomERC20ProposalHandlerFn(clientCtx),
+	}
+}
+
+func postUpdateCosmosDenomERC20ProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateCosmosDenomERC20ProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateCosmosDenomERC20Proposal(req.Title, req.Description, req.Denom, req.Erc20, req.DecimalShift)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -3,6 +3,8 @@ package rest
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 type (
@@ -18,4 +20,78 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolEthereumMultiSpendProposalReq defines a community pool Ethereum multi spend proposal request body.
+	CommunityPoolEthereumMultiSpendProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                             `json:"title" yaml:"title"`
+		Description string                             `json:"description" yaml:"description"`
+		Spends      []types.CommunityPoolEthereumSpend `json:"spends" yaml:"spends"`
+		Proposer    sdk.AccAddress                     `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins                          `json:"deposit" yaml:"deposit"`
+	}
+
+	// CommunityPoolEthereumContractCallProposalReq defines a community pool Ethereum contract call proposal request body.
+	CommunityPoolEthereumContractCallProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Address     string         `json:"address" yaml:"address"`
+		Payload     []byte         `json:"payload" yaml:"payload"`
+		Tokens      sdk.Coins      `json:"tokens" yaml:"tokens"`
+		Fees        sdk.Coins      `json:"fees" yaml:"fees"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CommunityPoolContractCallRefundProposalReq defines a community pool contract call refund proposal request body.
+	CommunityPoolContractCallRefundProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title             string         `json:"title" yaml:"title"`
+		Description       string         `json:"description" yaml:"description"`
+		InvalidationNonce uint64         `json:"invalidation_nonce" yaml:"invalidation_nonce"`
+		Proposer          sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit           sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// SignerSetTxCreationProposalReq defines a signer set tx creation proposal request body.
+	SignerSetTxCreationProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// RegisterCosmosDenomProposalReq defines a register cosmos denom proposal request body.
+	RegisterCosmosDenomProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title         string         `json:"title" yaml:"title"`
+		Description   string         `json:"description" yaml:"description"`
+		Denom         string         `json:"denom" yaml:"denom"`
+		Erc20Name     string         `json:"erc20_name" yaml:"erc20_name"`
+		Erc20Symbol   string         `json:"erc20_symbol" yaml:"erc20_symbol"`
+		Erc20Decimals uint64         `json:"erc20_decimals" yaml:"erc20_decimals"`
+		DecimalShift  int32          `json:"decimal_shift" yaml:"decimal_shift"`
+		Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit       sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// UpdateCosmosDenomERC20ProposalReq defines an update cosmos denom ERC20 proposal request body.
+	UpdateCosmosDenomERC20ProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title        string         `json:"title" yaml:"title"`
+		Description  string         `json:"description" yaml:"description"`
+		Denom        string         `json:"denom" yaml:"denom"`
+		Erc20        string         `json:"erc20" yaml:"erc20"`
+		DecimalShift int32          `json:"decimal_shift" yaml:"decimal_shift"`
+		Proposer     sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit      sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
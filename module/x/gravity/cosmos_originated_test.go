@@ -72,6 +72,11 @@ func addDenomToERC20Relation(tv *testingVars) {
 		Display: "atom",
 	})
 
+	// governance approves the deployment of an ERC20 for the denom
+	proposal := types.NewRegisterCosmosDenomProposal("register atom", "description", tv.denom, "atom", "atom", 6, 0)
+	require.NoError(tv.t, proposal.ValidateBasic())
+	require.NoError(tv.t, gravity.NewCommunityPoolEthereumSpendProposalHandler(tv.input.GravityKeeper)(tv.ctx, proposal))
+
 	var myNonce = uint64(1)
 
 	deployedEvent := &types.ERC20DeployedEvent{
@@ -107,6 +112,10 @@ func addDenomToERC20Relation(tv *testingVars) {
 
 	assert.Equal(tv.t, tv.denom, gotDenom)
 	assert.Equal(tv.t, tv.erc20, gotERC20.Hex())
+
+	// the registration is used up by the deployment
+	_, registered := tv.input.GravityKeeper.GetCosmosDenomRegistration(tv.ctx, tv.denom)
+	assert.False(tv.t, registered)
 }
 
 func lockCoinsInModule(tv *testingVars) {
@@ -33,10 +33,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSubmitEthereumTxConfirmations:
+			res, err := msgServer.SubmitEthereumTxConfirmations(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgSubmitEthereumEvent:
 			res, err := msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSubmitEthereumEvents:
+			res, err := msgServer.SubmitEthereumEvents(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgDelegateKeys:
 			res, err := msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -45,6 +53,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgRotateDelegateKeys:
+			res, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -56,6 +68,18 @@ func NewCommunityPoolEthereumSpendProposalHandler(k keeper.Keeper) govtypes.Hand
 		switch c := content.(type) {
 		case *types.CommunityPoolEthereumSpendProposal:
 			return k.HandleCommunityPoolEthereumSpendProposal(ctx, c)
+		case *types.CommunityPoolEthereumContractCallProposal:
+			return k.HandleCommunityPoolEthereumContractCallProposal(ctx, c)
+		case *types.CommunityPoolEthereumMultiSpendProposal:
+			return k.HandleCommunityPoolEthereumMultiSpendProposal(ctx, c)
+		case *types.CommunityPoolContractCallRefundProposal:
+			return k.HandleCommunityPoolContractCallRefundProposal(ctx, c)
+		case *types.SignerSetTxCreationProposal:
+			return k.HandleSignerSetTxCreationProposal(ctx, c)
+		case *types.RegisterCosmosDenomProposal:
+			return k.HandleRegisterCosmosDenomProposal(ctx, c)
+		case *types.UpdateCosmosDenomERC20Proposal:
+			return k.HandleUpdateCosmosDenomERC20Proposal(ctx, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
 		}
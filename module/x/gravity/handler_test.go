@@ -370,6 +370,15 @@ func TestMsgSetDelegateAddresses(t *testing.T) {
 	msg = types.NewMsgDelegateKeys(valAddress, cosmosAddress2, ethAddress2.String(), sig)
 	ctx = ctx.WithBlockTime(blockTime2).WithBlockHeight(blockHeight2)
 	_, err = h(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDelegateKeys)
+
+	require.Equal(t, ethAddress.String(), k.GetValidatorEthereumAddress(ctx, valAddress).Hex())
+	require.Equal(t, valAddress, k.GetOrchestratorValidatorAddress(ctx, cosmosAddress))
+	require.Nil(t, k.GetOrchestratorValidatorAddress(ctx, cosmosAddress2))
+
+	// replacing the keys requires rotating them
+	rotateMsg := types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress2, ethAddress2.String(), sig)
+	_, err = h(ctx, rotateMsg)
 	require.NoError(t, err)
 
 	require.Equal(t, ethAddress2.String(), k.GetValidatorEthereumAddress(ctx, valAddress).Hex())
@@ -385,3 +394,22 @@ func TestMsgSetDelegateAddresses(t *testing.T) {
 	_, err = k.DelegateKeysByValidator(wctx, &types.DelegateKeysByValidatorRequest{ValidatorAddress: valAddress.String()})
 	require.NoError(t, err)
 }
+
+func TestSignerSetTxCreationProposal(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	h := gravity.NewCommunityPoolEthereumSpendProposalHandler(gk)
+
+	gk.CreateSignerSetTx(ctx)
+	require.EqualValues(t, 1, len(gk.GetSignerSetTxs(ctx)))
+
+	// the proposal creates a signer set tx even though no power has changed
+	proposal := types.NewSignerSetTxCreationProposal("title", "description")
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, h(ctx, proposal))
+
+	latest := gk.GetLatestSignerSetTx(ctx)
+	require.EqualValues(t, 2, latest.Nonce)
+	require.Len(t, latest.Signers, 5)
+	require.Zero(t, types.EthereumSigners(gk.CurrentSignerSet(ctx)).PowerDiff(latest.Signers))
+}
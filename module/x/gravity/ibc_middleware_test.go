@@ -0,0 +1,105 @@
+package gravity_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// mockTransferApp credits the receiver of a packet with the given denom
+type mockTransferApp struct {
+	porttypes.IBCModule
+	bankKeeper bankkeeper.Keeper
+	denom      string
+	received   bool
+}
+
+func (m *mockTransferApp) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	amount, _ := sdk.NewIntFromString(data.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(m.denom, amount))
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+	if err := m.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+	if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+	m.received = true
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareForwardsToEthereum(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		voucherDenom  = types.GravityDenom(tokenContract)
+		receiver      = sdk.AccAddress([]byte("ibc-receiver-address"))
+		recipient     = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	)
+	transferApp := &mockTransferApp{bankKeeper: input.BankKeeper, denom: voucherDenom}
+	middleware := gravity.NewIBCMiddleware(transferApp, input.GravityKeeper)
+
+	// the voucher returns to this chain over the channel it left through
+	packet := func(denom, memo string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "100", "osmo1sender", receiver.String())
+		data.Memo = memo
+		return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-5", "transfer", "channel-0", clienttypes.ZeroHeight(), 1)
+	}
+	returningDenom := "transfer/channel-5/" + voucherDenom
+	unbatched := func() []*types.SendToEthereum {
+		res, err := input.GravityKeeper.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsRequest{SenderAddress: receiver.String()})
+		require.NoError(t, err)
+		return res.SendToEthereums
+	}
+
+	// transfers without a gravity memo are only received
+	ack := middleware.OnRecvPacket(ctx, packet(returningDenom, `{"other":{}}`), nil)
+	require.True(t, ack.Success())
+	require.True(t, transferApp.received)
+	require.Empty(t, unbatched())
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, receiver, voucherDenom).Amount)
+
+	// invalid forwards are rejected before the tokens are received
+	transferApp.received = false
+	ack = middleware.OnRecvPacket(ctx, packet(returningDenom, `{"gravity":{"ethereum_recipient":"not-an-address"}}`), nil)
+	require.False(t, ack.Success())
+	require.False(t, transferApp.received)
+
+	// the received tokens, less the bridge fee, are sent to Ethereum by the receiver
+	ack = middleware.OnRecvPacket(ctx, packet(returningDenom, `{"gravity":{"ethereum_recipient":"`+recipient+`","bridge_fee":"10"}}`), nil)
+	require.True(t, ack.Success())
+	sends := unbatched()
+	require.Len(t, sends, 1)
+	require.Equal(t, types.NewERC20Token(90, tokenContract), sends[0].Erc20Token)
+	require.Equal(t, types.NewERC20Token(10, tokenContract), sends[0].Erc20Fee)
+	require.Equal(t, recipient, sends[0].EthereumRecipient)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, receiver, voucherDenom).Amount)
+
+	// a bridge fee of the whole amount is rejected
+	ack = middleware.OnRecvPacket(ctx, packet(returningDenom, `{"gravity":{"ethereum_recipient":"`+recipient+`","bridge_fee":"100"}}`), nil)
+	require.False(t, ack.Success())
+
+	// tokens of other chains without an ERC20 can't be sent to Ethereum
+	transferApp.denom = transfertypes.ParseDenomTrace("transfer/channel-0/uosmo").IBCDenom()
+	ack = middleware.OnRecvPacket(ctx, packet("uosmo", `{"gravity":{"ethereum_recipient":"`+recipient+`"}}`), nil)
+	require.False(t, ack.Success())
+}
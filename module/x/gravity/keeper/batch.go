@@ -63,8 +63,8 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 }
 
 // batchTxExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
-// It deletes all the transactions in the batch, then cancels all earlier batches
-func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64) {
+// It records and deletes the batch, then cancels all earlier batches
+func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64, ethereumHeight uint64) {
 	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
 	if otx == nil {
 		k.Logger(ctx).Error("Failed to clean batches",
@@ -81,6 +81,7 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 		}
 		return false
 	})
+	k.recordExecutedBatchTx(ctx, batchTx, ethereumHeight)
 	k.DeleteOutgoingTx(ctx, batchTx.GetStoreIndex())
 }
 
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// newBridgedToken joins the bank metadata and supply of a denom with its ERC20
+func (k Keeper) newBridgedToken(ctx sdk.Context, denom string, erc20 common.Address, cosmosOriginated bool) types.BridgedToken {
+	token := types.BridgedToken{
+		Denom:            denom,
+		Erc20:            erc20.Hex(),
+		CosmosOriginated: cosmosOriginated,
+		TotalSupply:      k.bankKeeper.GetSupply(ctx, denom).Amount,
+		LockedAmount:     sdk.ZeroInt(),
+	}
+
+	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, denom); ok && md.Base != "" {
+		token.Display = md.Display
+		for _, denomUnit := range md.DenomUnits {
+			if denomUnit.Denom == md.Display {
+				token.Decimals = uint64(denomUnit.Exponent)
+				break
+			}
+		}
+	}
+
+	// Ethereum originated vouchers are burned when sent to Ethereum, Cosmos
+	// originated coins are held by the module until they come back
+	if cosmosOriginated {
+		token.DecimalShift = k.GetDenomDecimalShift(ctx, denom)
+		token.LockedAmount = k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), denom).Amount
+	}
+
+	return token
+}
+
+// GetBridgedToken returns the bridge details of a Cosmos originated denom with
+// a deployed ERC20, or of a gravity voucher denom with a supply
+func (k Keeper) GetBridgedToken(ctx sdk.Context, denom string) (types.BridgedToken, error) {
+	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, denom)
+	if err != nil {
+		return types.BridgedToken{}, sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	// any ERC20 maps to a voucher denom, it is only bridged once deposited
+	if !cosmosOriginated && k.bankKeeper.GetSupply(ctx, denom).IsZero() {
+		return types.BridgedToken{}, sdkerrors.Wrapf(types.ErrInvalid, "no supply of voucher denom %s", denom)
+	}
+
+	return k.newBridgedToken(ctx, denom, erc20, cosmosOriginated), nil
+}
+
+// PaginateBridgedTokens returns a page of the bridge details of the Cosmos
+// originated denoms with a deployed ERC20 and of the gravity voucher denoms
+// with a supply, ordered by denom. The Cosmos originated denoms are indexed by
+// their ERC20 while the vouchers only exist in the bank supply, so both are
+// gathered and paginated together by denom.
+func (k Keeper) PaginateBridgedTokens(ctx sdk.Context, pageReq *query.PageRequest) ([]types.BridgedToken, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	var tokens []types.BridgedToken
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20ToDenomKey}).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		tokens = append(tokens, k.newBridgedToken(ctx, string(iter.Value()), common.BytesToAddress(iter.Key()), true))
+	}
+	iter.Close()
+
+	k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if erc20, err := types.GravityDenomToERC20(coin.Denom); err == nil && coin.IsPositive() {
+			tokens = append(tokens, k.newBridgedToken(ctx, coin.Denom, common.HexToAddress(erc20), false))
+		}
+		return false
+	})
+
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Denom < tokens[j].Denom })
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := uint64(len(tokens))
+	if pageReq.Key != nil {
+		start = uint64(sort.Search(len(tokens), func(i int) bool { return tokens[i].Denom >= string(pageReq.Key) }))
+	} else if pageReq.Offset < start {
+		start = pageReq.Offset
+	}
+	end := start + limit
+	if end > uint64(len(tokens)) {
+		end = uint64(len(tokens))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(tokens)) {
+		pageRes.NextKey = []byte(tokens[end].Denom)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(tokens))
+	}
+
+	return tokens[start:end], pageRes, nil
+}
@@ -2,13 +2,19 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
-func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64) {
+func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64, ethereumHeight uint64) {
 	otx := k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(invalidationScope, invalidationNonce))
 	if otx == nil {
 		k.Logger(ctx).Error("Failed to clean contract calls",
@@ -19,14 +25,206 @@ func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte,
 
 	completedCallTx, _ := otx.(*types.ContractCallTx)
 	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		// If the iterated contract call's nonce is lower than the one that was just executed, delete it
+		// If the iterated contract call's nonce is lower than the one that was just executed, cancel it
 		cctx, _ := otx.(*types.ContractCallTx)
 		if (cctx.InvalidationNonce < completedCallTx.InvalidationNonce) &&
 			bytes.Equal(cctx.InvalidationScope, completedCallTx.InvalidationScope) {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+			if err := k.CancelContractCallTx(ctx, cctx); err != nil {
+				k.HoldContractCallRefund(ctx, cctx, err)
+			}
 		}
 		return false
 	})
 
+	k.recordExecutedContractCallTx(ctx, completedCallTx, ethereumHeight)
 	k.DeleteOutgoingTx(ctx, completedCallTx.GetStoreIndex())
 }
+
+// CancelContractCallTx deletes a contract call that can no longer be executed
+// on Ethereum, returning the tokens and fees of community pool calls to the
+// community pool. If the refund fails, nothing is changed and the error is
+// returned.
+func (k Keeper) CancelContractCallTx(ctx sdk.Context, cctx *types.ContractCallTx) error {
+	if bytes.Equal(cctx.InvalidationScope, types.CommunityPoolContractCallScope) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundCommunityPoolContractCall(cacheCtx, cctx); err != nil {
+			return err
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+	k.emitContractCallTxCanceled(ctx, cctx)
+
+	return nil
+}
+
+// HoldContractCallRefund moves a canceled community pool contract call whose
+// refund failed out of the outgoing txs. Its tokens and fees stay escrowed in
+// the Gravity module until a CommunityPoolContractCallRefundProposal retries
+// the refund.
+func (k Keeper) HoldContractCallRefund(ctx sdk.Context, cctx *types.ContractCallTx, err error) {
+	k.Logger(ctx).Error("community pool contract call refund failed, held for governance",
+		"nonce", cctx.InvalidationNonce, "error", err)
+
+	k.setUnrefundedContractCallTx(ctx, cctx)
+	k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractCallRefundFailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationNonce, fmt.Sprint(cctx.InvalidationNonce)),
+			sdk.NewAttribute(types.AttributeKeyContractCallRefundError, err.Error()),
+		),
+	)
+}
+
+func (k Keeper) emitContractCallTxCanceled(ctx sdk.Context, cctx *types.ContractCallTx) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractCallTxCanceled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationScope, fmt.Sprint(cctx.InvalidationScope)),
+			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationNonce, fmt.Sprint(cctx.InvalidationNonce)),
+		),
+	)
+}
+
+// lockForEthereum moves coins from a module account into the gravity module,
+// burning gravity vouchers, and returns their ERC20 representation
+func (k Keeper) lockForEthereum(ctx sdk.Context, senderModule string, coins sdk.Coins) ([]types.ERC20Token, error) {
+	if coins.IsZero() {
+		return nil, nil
+	}
+
+	var tokens []types.ERC20Token
+	var vouchers sdk.Coins
+	for _, coin := range coins {
+		isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		erc20Amount := coin.Amount
+		if isCosmosOriginated {
+			var dust sdk.Int
+			if erc20Amount, dust, err = types.ToERC20Amount(coin.Amount, k.GetDenomDecimalShift(ctx, coin.Denom)); err != nil {
+				return nil, err
+			}
+			if !dust.IsZero() {
+				return nil, sdkerrors.Wrapf(types.ErrInvalid, "%s is not a whole amount of ERC20 %s", coin, tokenContract.Hex())
+			}
+		} else {
+			vouchers = vouchers.Add(coin)
+		}
+
+		tokens = append(tokens, types.NewSDKIntERC20Token(erc20Amount, tokenContract))
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if !vouchers.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, vouchers); err != nil {
+			panic(err)
+		}
+	}
+
+	return tokens, nil
+}
+
+// unlockFromEthereum is the inverse of lockForEthereum, returning the ERC20
+// tokens to a module account as their cosmos coins
+func (k Keeper) unlockFromEthereum(ctx sdk.Context, recipientModule string, tokens []types.ERC20Token) (sdk.Coins, error) {
+	var coins, vouchers sdk.Coins
+	for _, token := range tokens {
+		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(token.Contract))
+		amount := token.Amount
+		if isCosmosOriginated {
+			// the locked amount scales back exactly, lockForEthereum rejects dust
+			var err error
+			if amount, _, err = types.FromERC20Amount(amount, k.GetDenomDecimalShift(ctx, denom)); err != nil {
+				return nil, err
+			}
+		} else {
+			vouchers = vouchers.Add(sdk.NewCoin(denom, amount))
+		}
+		coins = coins.Add(sdk.NewCoin(denom, amount))
+	}
+
+	if !vouchers.IsZero() {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
+
+func (k Keeper) refundCommunityPoolContractCall(ctx sdk.Context, cctx *types.ContractCallTx) error {
+	refund, err := k.unlockFromEthereum(ctx, distributiontypes.ModuleName, append(append([]types.ERC20Token{}, cctx.Tokens...), cctx.Fees...))
+	if err != nil {
+		return err
+	}
+
+	feePool := k.DistributionKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(refund...)...)
+	k.DistributionKeeper.SetFeePool(ctx, feePool)
+	k.Logger(ctx).Info("community pool contract call canceled and refunded", "nonce", cctx.InvalidationNonce, "amount", refund.String())
+
+	return nil
+}
+
+// getUnrefundedContractCallTx returns a held community pool contract call by
+// invalidation nonce, or nil if there is none
+func (k Keeper) getUnrefundedContractCallTx(ctx sdk.Context, invalidationNonce uint64) *types.ContractCallTx {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeUnrefundedContractCallTxKey(invalidationNonce))
+	if bz == nil {
+		return nil
+	}
+
+	var cctx types.ContractCallTx
+	k.cdc.MustUnmarshal(bz, &cctx)
+	return &cctx
+}
+
+func (k Keeper) setUnrefundedContractCallTx(ctx sdk.Context, cctx *types.ContractCallTx) {
+	ctx.KVStore(k.storeKey).Set(types.MakeUnrefundedContractCallTxKey(cctx.InvalidationNonce), k.cdc.MustMarshal(cctx))
+}
+
+func (k Keeper) deleteUnrefundedContractCallTx(ctx sdk.Context, invalidationNonce uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeUnrefundedContractCallTxKey(invalidationNonce))
+}
+
+// iterateUnrefundedContractCallTxs iterates over the held community pool
+// contract calls in invalidation nonce order
+func (k Keeper) iterateUnrefundedContractCallTxs(ctx sdk.Context, cb func(*types.ContractCallTx) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.UnrefundedContractCallTxKey})
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cctx types.ContractCallTx
+		k.cdc.MustUnmarshal(iter.Value(), &cctx)
+		if cb(&cctx) {
+			break
+		}
+	}
+}
+
+func (k Keeper) incrementLastCommunityPoolContractCallNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var nonce uint64
+	if bz := store.Get([]byte{types.LastCommunityPoolContractCallNonceKey}); bz != nil {
+		nonce = binary.BigEndian.Uint64(bz)
+	}
+	nonce++
+	store.Set([]byte{types.LastCommunityPoolContractCallNonceKey}, sdk.Uint64ToBigEndian(nonce))
+	return nonce
+}
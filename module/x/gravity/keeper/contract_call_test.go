@@ -70,7 +70,7 @@ func TestContractCallTxExecuted(t *testing.T) {
 	assert.Equal(t, cctx2.Tokens, erc20Tokens)
 	assert.Equal(t, cctx2.Fees, erc20Tokens)
 
-	input.GravityKeeper.contractCallExecuted(ctx, scope, nonce2)
+	input.GravityKeeper.contractCallExecuted(ctx, scope, nonce2, 0)
 
 	otx1 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1))
 	otx2 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2))
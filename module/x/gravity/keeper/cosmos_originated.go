@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -36,6 +37,120 @@ func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, t
 	store.Set(types.MakeERC20ToDenomKey(tokenContract), []byte(denom))
 }
 
+func (k Keeper) deleteCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, tokenContract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeDenomToERC20Key(denom))
+	store.Delete(types.MakeERC20ToDenomKey(tokenContract))
+}
+
+// getRetiredCosmosOriginatedERC20 returns the denom and decimal shift an ERC20
+// was mapped with before governance replaced or removed it
+func (k Keeper) getRetiredCosmosOriginatedERC20(ctx sdk.Context, tokenContract common.Address) (*types.ERC20ToDenom, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeRetiredERC20ToDenomKey(tokenContract))
+	if bz == nil {
+		return nil, false
+	}
+
+	var retired types.ERC20ToDenom
+	k.cdc.MustUnmarshal(bz, &retired)
+	return &retired, true
+}
+
+func (k Keeper) setRetiredCosmosOriginatedERC20(ctx sdk.Context, retired *types.ERC20ToDenom) {
+	ctx.KVStore(k.storeKey).Set(types.MakeRetiredERC20ToDenomKey(common.HexToAddress(retired.Erc20)), k.cdc.MustMarshal(retired))
+}
+
+func (k Keeper) deleteRetiredCosmosOriginatedERC20(ctx sdk.Context, tokenContract common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeRetiredERC20ToDenomKey(tokenContract))
+}
+
+// iterateRetiredCosmosOriginatedERC20s iterates over the ERC20s of Cosmos
+// originated denoms replaced or removed by governance
+func (k Keeper) iterateRetiredCosmosOriginatedERC20s(ctx sdk.Context, cb func(*types.ERC20ToDenom) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.RetiredERC20ToDenomKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var retired types.ERC20ToDenom
+		k.cdc.MustUnmarshal(iter.Value(), &retired)
+		// cb returns true to stop early
+		if cb(&retired) {
+			break
+		}
+	}
+}
+
+// depositDenom returns the denom and decimal shift a deposit of the given ERC20
+// is received as. ERC20s retired by governance still circulate on Ethereum as
+// claims on the locked denom, so their deposits keep releasing it.
+func (k Keeper) depositDenom(ctx sdk.Context, tokenContract common.Address) (isCosmosOriginated bool, denom string, decimalShift int32) {
+	isCosmosOriginated, denom = k.ERC20ToDenomLookup(ctx, tokenContract)
+	if isCosmosOriginated {
+		return true, denom, k.GetDenomDecimalShift(ctx, denom)
+	}
+	if retired, ok := k.getRetiredCosmosOriginatedERC20(ctx, tokenContract); ok {
+		return true, retired.Denom, retired.DecimalShift
+	}
+	return false, denom, 0
+}
+
+// GetDenomDecimalShift returns the number of decimals the ERC20 of a Cosmos
+// originated denom has beyond the denom, zero if the mapping has no shift
+func (k Keeper) GetDenomDecimalShift(ctx sdk.Context, denom string) int32 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeDenomDecimalShiftKey(denom))
+	if bz == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(bz))
+}
+
+func (k Keeper) setDenomDecimalShift(ctx sdk.Context, denom string, decimalShift int32) {
+	store := ctx.KVStore(k.storeKey)
+	if decimalShift == 0 {
+		store.Delete(types.MakeDenomDecimalShiftKey(denom))
+		return
+	}
+
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(decimalShift))
+	store.Set(types.MakeDenomDecimalShiftKey(denom), bz)
+}
+
+// hasPendingTransfers returns true if any unbatched send to ethereum, batch tx
+// or contract call tx still moves the given ERC20
+func (k Keeper) hasPendingTransfers(ctx sdk.Context, tokenContract common.Address) bool {
+	pending := false
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(*types.SendToEthereum) bool {
+		pending = true
+		return true
+	})
+	if pending {
+		return true
+	}
+
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx, _ := otx.(*types.BatchTx)
+		pending = common.HexToAddress(btx.TokenContract) == tokenContract
+		return pending
+	})
+	if pending {
+		return true
+	}
+
+	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		cctx, _ := otx.(*types.ContractCallTx)
+		for _, token := range append(append([]types.ERC20Token{}, cctx.Tokens...), cctx.Fees...) {
+			if common.HexToAddress(token.Contract) == tokenContract {
+				pending = true
+			}
+		}
+		return pending
+	})
+
+	return pending
+}
+
 // DenomToERC20 returns (bool isCosmosOriginated, string ERC20, err)
 // Using this information, you can see if an asset is native to Cosmos or Ethereum,
 // and get its corresponding ERC20 address.
@@ -82,8 +197,9 @@ func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 
 	for ; iter.Valid(); iter.Next() {
 		erc20ToDenom := types.ERC20ToDenom{
-			Erc20: string(iter.Key()),
-			Denom: string(iter.Value()),
+			Erc20:        string(iter.Key()),
+			Denom:        string(iter.Value()),
+			DecimalShift: k.GetDenomDecimalShift(ctx, string(iter.Value())),
 		}
 		// cb returns true to stop early
 		if cb(iter.Key(), &erc20ToDenom) {
@@ -91,3 +207,39 @@ func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 		}
 	}
 }
+
+// GetCosmosDenomRegistration returns the governance approval to deploy an ERC20 for a Cosmos originated denom
+func (k Keeper) GetCosmosDenomRegistration(ctx sdk.Context, denom string) (*types.CosmosDenomRegistration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeCosmosDenomRegistrationKey(denom))
+	if bz == nil {
+		return nil, false
+	}
+
+	var registration types.CosmosDenomRegistration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return &registration, true
+}
+
+func (k Keeper) setCosmosDenomRegistration(ctx sdk.Context, registration *types.CosmosDenomRegistration) {
+	ctx.KVStore(k.storeKey).Set(types.MakeCosmosDenomRegistrationKey(registration.Denom), k.cdc.MustMarshal(registration))
+}
+
+func (k Keeper) deleteCosmosDenomRegistration(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeCosmosDenomRegistrationKey(denom))
+}
+
+// iterateCosmosDenomRegistrations iterates over the Cosmos originated denoms approved for ERC20 deployment
+func (k Keeper) iterateCosmosDenomRegistrations(ctx sdk.Context, cb func(*types.CosmosDenomRegistration) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.CosmosDenomRegistrationKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var registration types.CosmosDenomRegistration
+		k.cdc.MustUnmarshal(iter.Value(), &registration)
+		// cb returns true to stop early
+		if cb(&registration) {
+			break
+		}
+	}
+}
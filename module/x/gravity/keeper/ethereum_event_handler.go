@@ -26,11 +26,23 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	switch event := eve.(type) {
 	case *types.SendToCosmosEvent:
 		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(event.TokenContract))
-		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
+		isCosmosOriginated, denom, decimalShift := k.depositDenom(ctx, common.HexToAddress(event.TokenContract))
+		localAddress, forward := types.SplitCosmosReceiver(event.CosmosReceiver)
+		addr, _ := sdk.AccAddressFromBech32(localAddress)
 		coins := sdk.Coins{sdk.NewCoin(denom, event.Amount)}
 
-		if !isCosmosOriginated {
+		if isCosmosOriginated {
+			// ERC20 amounts are scaled to the denom's decimals, the dust the denom
+			// can't represent stays locked in the Gravity contract
+			amount, dust, err := types.FromERC20Amount(event.Amount, decimalShift)
+			if err != nil {
+				return err
+			}
+			if !dust.IsZero() {
+				k.Logger(ctx).Info("send to cosmos dust left on ethereum", "token contract", event.TokenContract, "dust", dust, "event nonce", event.EventNonce)
+			}
+			coins = sdk.Coins{sdk.NewCoin(denom, amount)}
+		} else {
 			if err := k.DetectMaliciousSupply(ctx, denom, event.Amount); err != nil {
 				return err
 			}
@@ -39,22 +51,36 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 			}
+
+			if event.Erc20Metadata != nil {
+				k.setVoucherMetadata(ctx, common.HexToAddress(event.TokenContract), event.Erc20Metadata)
+			}
 		}
 
-		if recipientModule, ok := k.ReceiverModuleAccounts[event.CosmosReceiver]; ok {
-			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
+		// nothing is received when the whole amount was dust
+		if coins[0].IsZero() {
+			k.AfterSendToCosmosEvent(ctx, *event)
+			return nil
+		}
+
+		if module, ok := k.receivingModules[localAddress]; ok {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, module.name, coins); err != nil {
 				return err
 			}
+			k.onSendToCosmos(ctx, module, *event, coins)
 		} else {
 			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
 				return err
 			}
+			if forward != "" {
+				k.forwardOverIBC(ctx, addr, coins[0], forward)
+			}
 		}
 		k.AfterSendToCosmosEvent(ctx, *event)
 		return nil
 
 	case *types.BatchExecutedEvent:
-		k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce)
+		k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce, event.EthereumHeight)
 		k.AfterBatchExecutedEvent(ctx, *event)
 		return nil
 
@@ -63,13 +89,16 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			return err
 		}
 
-		// add to denom-erc20 mapping
+		// add to denom-erc20 mapping, the approval is used up by the deployment
+		registration, _ := k.GetCosmosDenomRegistration(ctx, event.CosmosDenom)
 		k.setCosmosOriginatedDenomToERC20(ctx, event.CosmosDenom, common.HexToAddress(event.TokenContract))
+		k.setDenomDecimalShift(ctx, event.CosmosDenom, registration.DecimalShift)
+		k.deleteCosmosDenomRegistration(ctx, event.CosmosDenom)
 		k.AfterERC20DeployedEvent(ctx, *event)
 		return nil
 
 	case *types.ContractCallExecutedEvent:
-		k.contractCallExecuted(ctx, event.InvalidationScope.Bytes(), event.InvalidationNonce)
+		k.contractCallExecuted(ctx, event.InvalidationScope.Bytes(), event.InvalidationNonce, event.EthereumHeight)
 		k.AfterContractCallExecutedEvent(ctx, *event)
 		return nil
 
@@ -81,6 +110,7 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
 		})
+		k.pruneRotatedEthereumAddresses(ctx, event.Members)
 		k.AfterSignerSetExecutedEvent(ctx, *event)
 		return nil
 
@@ -89,6 +119,24 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	}
 }
 
+// setVoucherMetadata registers bank metadata for the voucher of an Ethereum
+// originated ERC20 the first time orchestrators attest its metadata. Invalid
+// metadata is skipped, so that it can never block a deposit.
+func (k Keeper) setVoucherMetadata(ctx sdk.Context, tokenContract common.Address, erc20Metadata *types.ERC20Metadata) {
+	denom := types.GravityDenom(tokenContract)
+	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, denom); ok && md.Base != "" {
+		return
+	}
+
+	md := erc20Metadata.VoucherMetadata(tokenContract)
+	if err := md.Validate(); err != nil {
+		k.Logger(ctx).Error("invalid attested ERC20 metadata", "token contract", tokenContract.Hex(), "error", err)
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, md)
+}
+
 func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20DeployedEvent) error {
 	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, event.CosmosDenom); exists {
 		return sdkerrors.Wrapf(
@@ -97,6 +145,33 @@ func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20Depl
 		)
 	}
 
+	// Only denoms approved by governance may have an ERC20 deployed for them,
+	// with the metadata governance expects if it set any
+	registration, ok := k.GetCosmosDenomRegistration(ctx, event.CosmosDenom)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrCosmosDenomNotRegistered, "denom %s", event.CosmosDenom)
+	}
+	if registration.Erc20Name != "" {
+		if event.Erc20Name != registration.Erc20Name {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidERC20Event,
+				"ERC20 name does not match registration; got: %s, expected: %s", event.Erc20Name, registration.Erc20Name,
+			)
+		}
+		if event.Erc20Symbol != registration.Erc20Symbol {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidERC20Event,
+				"ERC20 symbol does not match registration; got: %s, expected: %s", event.Erc20Symbol, registration.Erc20Symbol,
+			)
+		}
+		if event.Erc20Decimals != registration.Erc20Decimals {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidERC20Event,
+				"ERC20 decimals do not match registration; got: %d, expected: %d", event.Erc20Decimals, registration.Erc20Decimals,
+			)
+		}
+	}
+
 	// We expect that all Cosmos-based tokens have metadata defined. In the case
 	// a token does not have metadata defined, e.g. an IBC token, we successfully
 	// handle the token under the following conditions:
@@ -104,14 +179,15 @@ func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20Depl
 	// 1. The ERC20 name is equal to the token's denomination. Otherwise, this
 	// 		means that ERC20 tokens would have an untenable UX.
 	// 2. The ERC20 token has zero decimals as this is what we default to since
-	// 		we cannot know or infer the real decimal value for the Cosmos token.
+	// 		we cannot know or infer the real decimal value for the Cosmos token,
+	// 		unless governance registered the denom with a decimal shift.
 	// 3. The ERC20 symbol is empty.
 	//
 	// NOTE: This path is not encouraged and all supported assets should have
 	// metadata defined. If metadata cannot be defined, consider adding the token's
 	// metadata on the fly.
 	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, event.CosmosDenom); ok && md.Base != "" {
-		return verifyERC20Token(md, event)
+		return verifyERC20Token(md, event, registration.DecimalShift)
 	}
 
 	if supply := k.bankKeeper.GetSupply(ctx, event.CosmosDenom); supply.IsZero() {
@@ -135,17 +211,17 @@ func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20Depl
 		)
 	}
 
-	if event.Erc20Decimals != 0 {
+	if int64(event.Erc20Decimals) != int64(registration.DecimalShift) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
-			"expected zero ERC20 decimals for token without metadata; got: %d", event.Erc20Decimals,
+			"invalid ERC20 decimals for token without metadata; got: %d, expected: %d", event.Erc20Decimals, registration.DecimalShift,
 		)
 	}
 
 	return nil
 }
 
-func verifyERC20Token(metadata banktypes.Metadata, event *types.ERC20DeployedEvent) error {
+func verifyERC20Token(metadata banktypes.Metadata, event *types.ERC20DeployedEvent, decimalShift int32) error {
 	if event.Erc20Name != metadata.Display {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
@@ -176,6 +252,9 @@ func verifyERC20Token(metadata banktypes.Metadata, event *types.ERC20DeployedEve
 	// result in there being no decimal places in the token's ERC20 on Ethereum.
 	// For example, if this happened with ATOM, 1 ATOM would appear on Ethereum
 	// as 1 million ATOM, having 6 extra places before the decimal point.
+	//
+	// A decimal shift registered by governance lets the ERC20 have more or
+	// fewer decimals than the denom, amounts are then scaled between them.
 	var decimals uint32
 	for _, denomUnit := range metadata.DenomUnits {
 		if denomUnit.Denom == metadata.Display {
@@ -184,10 +263,10 @@ func verifyERC20Token(metadata banktypes.Metadata, event *types.ERC20DeployedEve
 		}
 	}
 
-	if uint64(decimals) != event.Erc20Decimals {
+	if int64(event.Erc20Decimals) != int64(decimals)+int64(decimalShift) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
-			"ERC20 decimals %d does not match denom decimals %d", event.Erc20Decimals, decimals,
+			"ERC20 decimals %d does not match denom decimals %d shifted by %d", event.Erc20Decimals, decimals, decimalShift,
 		)
 	}
 
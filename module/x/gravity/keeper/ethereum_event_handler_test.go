@@ -2,10 +2,15 @@ package keeper
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 func TestDetectMaliciousSupply(t *testing.T) {
@@ -19,3 +24,132 @@ func TestDetectMaliciousSupply(t *testing.T) {
 	err := input.GravityKeeper.DetectMaliciousSupply(input.Context, "stake", bigCoinAmount)
 	require.Error(t, err, "didn't error out on too much added supply")
 }
+
+func TestERC20DeployedEventRequiresRegistration(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	input.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	})
+
+	event := &types.ERC20DeployedEvent{
+		CosmosDenom:   "uatom",
+		TokenContract: "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+		Erc20Name:     "atom",
+		Erc20Symbol:   "atom",
+		Erc20Decimals: 6,
+		EventNonce:    1,
+	}
+
+	// deployments for denoms governance has not approved are rejected
+	require.ErrorIs(t, k.Handle(ctx, event), types.ErrCosmosDenomNotRegistered)
+
+	// as are deployments that don't match the metadata governance expects
+	require.NoError(t, k.HandleRegisterCosmosDenomProposal(ctx, types.NewRegisterCosmosDenomProposal("title", "description", "uatom", "atom", "ATOM", 6, 0)))
+	require.ErrorIs(t, k.Handle(ctx, event), types.ErrInvalidERC20Event)
+	_, exists := k.getCosmosOriginatedERC20(ctx, "uatom")
+	require.False(t, exists)
+
+	require.NoError(t, k.HandleRegisterCosmosDenomProposal(ctx, types.NewRegisterCosmosDenomProposal("title", "description", "uatom", "atom", "atom", 6, 0)))
+	require.NoError(t, k.Handle(ctx, event))
+	erc20, exists := k.getCosmosOriginatedERC20(ctx, "uatom")
+	require.True(t, exists)
+	require.Equal(t, event.TokenContract, strings.ToLower(erc20.Hex()))
+
+	// once deployed, the denom can't be registered again
+	require.Error(t, k.HandleRegisterCosmosDenomProposal(ctx, types.NewRegisterCosmosDenomProposal("title", "description", "uatom", "", "", 0, 0)))
+}
+
+func TestERC20DeployedEventWithDecimalShift(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	input.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	})
+	require.NoError(t, k.HandleRegisterCosmosDenomProposal(ctx, types.NewRegisterCosmosDenomProposal("title", "description", "uatom", "", "", 0, 12)))
+
+	event := &types.ERC20DeployedEvent{
+		CosmosDenom:   "uatom",
+		TokenContract: "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+		Erc20Name:     "atom",
+		Erc20Symbol:   "atom",
+		Erc20Decimals: 6,
+		EventNonce:    1,
+	}
+
+	// the ERC20 must have the denom decimals shifted by the registered amount
+	require.ErrorIs(t, k.Handle(ctx, event), types.ErrInvalidERC20Event)
+
+	event.Erc20Decimals = 18
+	require.NoError(t, k.Handle(ctx, event))
+	require.EqualValues(t, 12, k.GetDenomDecimalShift(ctx, "uatom"))
+}
+
+func TestSendToCosmosEventSetsVoucherMetadata(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	receiver := sdktypes.AccAddress([]byte("voucher-receiver-addr"))
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdktypes.NewInt(100),
+		EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 10,
+	}
+
+	// deposits without attested metadata leave the voucher without metadata
+	withoutMetadataHash := event.Hash()
+	require.NoError(t, k.Handle(ctx, event))
+	_, ok := input.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(tokenContract))
+	require.False(t, ok)
+
+	event.Erc20Metadata = &types.ERC20Metadata{Name: "Pickle Token", Symbol: "PICKLE", Decimals: 18}
+	require.NotEqual(t, withoutMetadataHash, event.Hash())
+	require.NoError(t, event.Validate())
+	require.NoError(t, k.Handle(ctx, event))
+
+	md, ok := input.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(tokenContract))
+	require.True(t, ok)
+	require.NoError(t, md.Validate())
+	require.Equal(t, types.GravityDenom(tokenContract), md.Base)
+	require.Equal(t, "PICKLE", md.Display)
+	require.Equal(t, "Pickle Token", md.Name)
+	require.EqualValues(t, 18, md.DenomUnits[1].Exponent)
+
+	// later attestations don't replace registered metadata
+	event.Erc20Metadata = &types.ERC20Metadata{Name: "Other", Symbol: "OTHER", Decimals: 6}
+	require.NoError(t, k.Handle(ctx, event))
+	md, _ = input.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(tokenContract))
+	require.Equal(t, "PICKLE", md.Display)
+
+	// symbols that aren't valid denoms get a display unit named after the voucher
+	otherContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	event.TokenContract = otherContract.Hex()
+	event.Erc20Metadata = &types.ERC20Metadata{Name: "1inch", Symbol: "1INCH", Decimals: 18}
+	require.NoError(t, k.Handle(ctx, event))
+	md, ok = input.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(otherContract))
+	require.True(t, ok)
+	require.Equal(t, types.GravityDenom(otherContract)+"/display", md.Display)
+
+	// metadata orchestrators can't have read from an ERC20 is rejected
+	event.Erc20Metadata = &types.ERC20Metadata{Name: "", Symbol: "X", Decimals: 300}
+	require.Error(t, event.Validate())
+}
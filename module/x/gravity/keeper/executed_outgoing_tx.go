@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// recordExecutedBatchTx keeps a compact record of a batch executed on Ethereum
+func (k Keeper) recordExecutedBatchTx(ctx sdk.Context, batch *types.BatchTx, ethereumHeight uint64) {
+	record := &types.ExecutedOutgoingTx{
+		StoreIndex:     batch.GetStoreIndex(),
+		Nonce:          batch.BatchNonce,
+		TokenContracts: []string{batch.TokenContract},
+		EthereumHeight: ethereumHeight,
+		CosmosHeight:   uint64(ctx.BlockHeight()),
+	}
+
+	seen := map[string]bool{}
+	for _, tx := range batch.Transactions {
+		record.TxIds = append(record.TxIds, tx.Id)
+		if !seen[tx.Sender] {
+			seen[tx.Sender] = true
+			record.Senders = append(record.Senders, tx.Sender)
+		}
+	}
+
+	k.setExecutedOutgoingTx(ctx, record)
+}
+
+// recordExecutedContractCallTx keeps a compact record of a contract call executed on Ethereum
+func (k Keeper) recordExecutedContractCallTx(ctx sdk.Context, call *types.ContractCallTx, ethereumHeight uint64) {
+	record := &types.ExecutedOutgoingTx{
+		StoreIndex:     call.GetStoreIndex(),
+		Nonce:          call.InvalidationNonce,
+		EthereumHeight: ethereumHeight,
+		CosmosHeight:   uint64(ctx.BlockHeight()),
+	}
+
+	seen := map[common.Address]bool{}
+	for _, token := range append(append([]types.ERC20Token{}, call.Tokens...), call.Fees...) {
+		contract := common.HexToAddress(token.Contract)
+		if !seen[contract] {
+			seen[contract] = true
+			record.TokenContracts = append(record.TokenContracts, contract.Hex())
+		}
+	}
+
+	k.setExecutedOutgoingTx(ctx, record)
+}
+
+// setExecutedOutgoingTx stores an executed outgoing tx record and indexes it by
+// each of its token contracts and senders
+func (k Keeper) setExecutedOutgoingTx(ctx sdk.Context, record *types.ExecutedOutgoingTx) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.MakeExecutedOutgoingTxKey(record.CosmosHeight, record.StoreIndex)
+	store.Set(key, k.cdc.MustMarshal(record))
+
+	for _, indexPrefix := range k.executedOutgoingTxIndexPrefixes(ctx, record) {
+		store.Set(append(indexPrefix, key[1:]...), []byte{})
+	}
+}
+
+// GetExecutedOutgoingTx returns the record of an outgoing tx executed at the given cosmos height
+func (k Keeper) GetExecutedOutgoingTx(ctx sdk.Context, cosmosHeight uint64, storeIndex []byte) *types.ExecutedOutgoingTx {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeExecutedOutgoingTxKey(cosmosHeight, storeIndex))
+	if bz == nil {
+		return nil
+	}
+
+	var record types.ExecutedOutgoingTx
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+// IterateExecutedOutgoingTxs iterates over the executed outgoing tx records in
+// order of the cosmos height they were executed at
+func (k Keeper) IterateExecutedOutgoingTxs(ctx sdk.Context, cb func(record *types.ExecutedOutgoingTx) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ExecutedOutgoingTxKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.ExecutedOutgoingTx
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(&record) {
+			break
+		}
+	}
+}
+
+// PruneExecutedOutgoingTxs deletes the executed outgoing tx records, and their
+// indexes, of txs executed before the given cosmos height
+func (k Keeper) PruneExecutedOutgoingTxs(ctx sdk.Context, beforeHeight uint64) {
+	var records []*types.ExecutedOutgoingTx
+	k.IterateExecutedOutgoingTxs(ctx, func(record *types.ExecutedOutgoingTx) bool {
+		if record.CosmosHeight >= beforeHeight {
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, record := range records {
+		key := types.MakeExecutedOutgoingTxKey(record.CosmosHeight, record.StoreIndex)
+		for _, indexPrefix := range k.executedOutgoingTxIndexPrefixes(ctx, record) {
+			store.Delete(append(indexPrefix, key[1:]...))
+		}
+		store.Delete(key)
+	}
+}
+
+// PaginateExecutedOutgoingTxsByToken returns a page of the records of executed
+// outgoing txs that moved the given token
+func (k Keeper) PaginateExecutedOutgoingTxsByToken(ctx sdk.Context, pageReq *query.PageRequest, tokenContract common.Address) ([]*types.ExecutedOutgoingTx, *query.PageResponse, error) {
+	return k.paginateExecutedOutgoingTxIndex(ctx, pageReq, types.MakeExecutedOutgoingTxByTokenPrefix(tokenContract))
+}
+
+// PaginateExecutedOutgoingTxsBySender returns a page of the records of executed
+// outgoing txs that included a send to ethereum from the given sender
+func (k Keeper) PaginateExecutedOutgoingTxsBySender(ctx sdk.Context, pageReq *query.PageRequest, sender sdk.AccAddress) ([]*types.ExecutedOutgoingTx, *query.PageResponse, error) {
+	return k.paginateExecutedOutgoingTxIndex(ctx, pageReq, types.MakeExecutedOutgoingTxBySenderPrefix(sender))
+}
+
+func (k Keeper) paginateExecutedOutgoingTxIndex(ctx sdk.Context, pageReq *query.PageRequest, indexPrefix []byte) ([]*types.ExecutedOutgoingTx, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+
+	var records []*types.ExecutedOutgoingTx
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		var record types.ExecutedOutgoingTx
+		k.cdc.MustUnmarshal(store.Get(append([]byte{types.ExecutedOutgoingTxKey}, key...)), &record)
+		records = append(records, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
+
+// executedOutgoingTxIndexPrefixes returns the token and sender index prefixes
+// an executed outgoing tx record is stored under
+func (k Keeper) executedOutgoingTxIndexPrefixes(ctx sdk.Context, record *types.ExecutedOutgoingTx) [][]byte {
+	var prefixes [][]byte
+	for _, token := range record.TokenContracts {
+		prefixes = append(prefixes, types.MakeExecutedOutgoingTxByTokenPrefix(common.HexToAddress(token)))
+	}
+	for _, sender := range record.Senders {
+		addr, err := sdk.AccAddressFromBech32(sender)
+		if err != nil {
+			k.Logger(ctx).Error("invalid executed outgoing tx sender", "sender", sender, "error", err)
+			continue
+		}
+		prefixes = append(prefixes, types.MakeExecutedOutgoingTxBySenderPrefix(addr))
+	}
+
+	return prefixes
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestExecutedOutgoingTxHistory(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(100)
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender   = sdk.AccAddress([]byte("other-sender-address"))
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		feeContract   = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		vouchers      = sdk.NewCoins(types.NewERC20Token(99999, tokenContract).GravityCoin())
+	)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, vouchers))
+
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, mySender, myReceiver, 2, 3)
+	batch := gk.BuildBatchTx(ctx, tokenContract, 2)
+	require.NotNil(t, batch)
+
+	gk.batchTxExecuted(ctx, tokenContract, batch.BatchNonce, 1000)
+	require.Nil(t, gk.GetOutgoingTx(ctx, batch.GetStoreIndex()))
+
+	expBatchRecord := &types.ExecutedOutgoingTx{
+		StoreIndex:     batch.GetStoreIndex(),
+		Nonce:          batch.BatchNonce,
+		TokenContracts: []string{tokenContract.Hex()},
+		TxIds:          []uint64{batch.Transactions[0].Id, batch.Transactions[1].Id},
+		Senders:        []string{mySender.String()},
+		EthereumHeight: 1000,
+		CosmosHeight:   100,
+	}
+	require.Equal(t, expBatchRecord, gk.GetExecutedOutgoingTx(ctx, 100, batch.GetStoreIndex()))
+
+	// a contract call paying its fees in another token executes later
+	ctx = ctx.WithBlockHeight(200)
+	scope := []byte("test-scope")
+	gk.CreateContractCallTx(ctx, 1, scope, common.HexToAddress("0xc783df8a850f42e7F7e57013759C285caa701eB6"), []byte("payload"),
+		[]types.ERC20Token{types.NewERC20Token(10, tokenContract)},
+		[]types.ERC20Token{types.NewERC20Token(1, feeContract), types.NewERC20Token(1, tokenContract)},
+	)
+	gk.contractCallExecuted(ctx, scope, 1, 2000)
+
+	callRecord := gk.GetExecutedOutgoingTx(ctx, 200, types.MakeContractCallTxKey(scope, 1))
+	require.NotNil(t, callRecord)
+	require.Equal(t, []string{tokenContract.Hex(), feeContract.Hex()}, callRecord.TokenContracts)
+	require.Empty(t, callRecord.Senders)
+	require.EqualValues(t, 2000, callRecord.EthereumHeight)
+
+	byToken, err := gk.ExecutedOutgoingTxsByToken(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsByTokenRequest{
+		TokenContract: tokenContract.Hex(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ExecutedOutgoingTx{expBatchRecord, callRecord}, byToken.ExecutedTxs)
+
+	byToken, err = gk.ExecutedOutgoingTxsByToken(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsByTokenRequest{
+		TokenContract: tokenContract.Hex(),
+		Pagination:    &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ExecutedOutgoingTx{expBatchRecord}, byToken.ExecutedTxs)
+	require.EqualValues(t, 2, byToken.Pagination.Total)
+
+	byToken, err = gk.ExecutedOutgoingTxsByToken(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsByTokenRequest{
+		TokenContract: feeContract.Hex(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ExecutedOutgoingTx{callRecord}, byToken.ExecutedTxs)
+
+	bySender, err := gk.ExecutedOutgoingTxsBySender(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsBySenderRequest{
+		SenderAddress: mySender.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ExecutedOutgoingTx{expBatchRecord}, bySender.ExecutedTxs)
+
+	bySender, err = gk.ExecutedOutgoingTxsBySender(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsBySenderRequest{
+		SenderAddress: otherSender.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, bySender.ExecutedTxs)
+
+	_, err = gk.ExecutedOutgoingTxsBySender(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsBySenderRequest{
+		SenderAddress: "not-an-address",
+	})
+	require.Error(t, err)
+
+	// pruning removes the batch record and its indexes, but keeps the later call
+	gk.PruneExecutedOutgoingTxs(ctx, 150)
+	require.Nil(t, gk.GetExecutedOutgoingTx(ctx, 100, batch.GetStoreIndex()))
+
+	bySender, err = gk.ExecutedOutgoingTxsBySender(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsBySenderRequest{
+		SenderAddress: mySender.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, bySender.ExecutedTxs)
+
+	byToken, err = gk.ExecutedOutgoingTxsByToken(sdk.WrapSDKContext(ctx), &types.ExecutedOutgoingTxsByTokenRequest{
+		TokenContract: tokenContract.Hex(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ExecutedOutgoingTx{callRecord}, byToken.ExecutedTxs)
+}
@@ -59,16 +59,64 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
 		eth := common.HexToAddress(keys.EthereumAddress)
 
+		if k.validatorForEthAddressExists(ctx, eth) {
+			panic(fmt.Sprintf("Duplicate ethereum address in Genesis delegate keys: %s", eth.Hex()))
+		}
+		if k.ethAddressForOrchestratorExists(ctx, orch) {
+			panic(fmt.Sprintf("Duplicate orchestrator address in Genesis delegate keys: %s", orch))
+		}
+
 		// set the orchestrator address
 		k.SetOrchestratorValidatorAddress(ctx, val, orch)
 		// set the ethereum address
-		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
+		k.setValidatorEthereumAddress(ctx, val, eth)
 		k.setEthereumOrchestratorAddress(ctx, eth, orch)
 	}
 
+	// reset pending ethereum key rotations, signatures made with the rotated
+	// away from addresses are resolved to their validators below
+	rotatedSigners := make(map[common.Address]sdk.ValAddress)
+	for _, rotated := range data.RotatedEthereumAddresses {
+		if err := rotated.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid rotated ethereum address in genesis: %s", err))
+		}
+
+		val, _ := sdk.ValAddressFromBech32(rotated.ValidatorAddress)
+		eth := common.HexToAddress(rotated.EthereumAddress)
+		k.setRotatedEthereumAddress(ctx, val, eth)
+		rotatedSigners[eth] = val
+	}
+
 	// populate state with cosmos originated denom-erc20 mapping
 	for _, item := range data.Erc20ToDenoms {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, common.HexToAddress(item.Erc20))
+		k.setDenomDecimalShift(ctx, item.Denom, item.DecimalShift)
+	}
+
+	// populate state with the retired ERC20s of cosmos originated denoms
+	for _, retired := range data.RetiredErc20ToDenoms {
+		if err := retired.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid retired erc20 to denom in genesis: %s", err))
+		}
+		k.setRetiredCosmosOriginatedERC20(ctx, retired)
+	}
+
+	// populate state with the cosmos originated denoms approved for ERC20 deployment
+	for _, registration := range data.CosmosDenomRegistrations {
+		k.setCosmosDenomRegistration(ctx, registration)
+	}
+
+	// reset the executed outgoing tx history and its indexes
+	for _, record := range data.ExecutedOutgoingTxs {
+		if err := record.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid executed outgoing tx in genesis: %s", err))
+		}
+		k.setExecutedOutgoingTx(ctx, record)
+	}
+
+	// reset the canceled community pool contract calls awaiting a refund
+	for _, cctx := range data.UnrefundedContractCallTxs {
+		k.setUnrefundedContractCallTx(ctx, cctx)
 	}
 
 	// reset outgoing txs in state
@@ -86,10 +134,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic(fmt.Sprintf("invalid etheruem signature in genesis: %s", err))
 		}
-		// TODO: not currently an easy way to get the validator address from the
-		// etherum address here. once we implement the third index for keys
-		// this will be easy.
-		k.SetEthereumSignature(ctx, conf, sdk.ValAddress{})
+		// signatures are exported with the Ethereum address that made them,
+		// and delegate keys and pending rotations are imported above
+		val := k.GetEthereumValidatorAddress(ctx, conf.GetSigner())
+		if val == nil {
+			val = rotatedSigners[conf.GetSigner()]
+		}
+		if val == nil {
+			panic(fmt.Sprintf("ethereum signature in genesis from unknown signer: %s", conf.GetSigner().Hex()))
+		}
+		k.SetEthereumSignature(ctx, conf, val)
 	}
 }
 
@@ -105,6 +159,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		delegates                = k.getDelegateKeys(ctx)
 		lastobserved             = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms            []*types.ERC20ToDenom
+		registrations            []*types.CosmosDenomRegistration
+		executedTxs              []*types.ExecutedOutgoingTx
+		retiredERC20s            []*types.ERC20ToDenom
+		rotatedAddresses         []*types.RotatedEthereumAddress
+		unrefundedCalls          []*types.ContractCallTx
+		gravityID                = []byte(k.getGravityID(ctx))
 		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx)
 	)
 
@@ -120,13 +180,44 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	// export retired erc20 to denom relations
+	k.iterateRetiredCosmosOriginatedERC20s(ctx, func(retired *types.ERC20ToDenom) bool {
+		retiredERC20s = append(retiredERC20s, retired)
+		return false
+	})
+
+	// export pending ethereum key rotations
+	k.iterateRotatedEthereumAddresses(ctx, func(val sdk.ValAddress, ethAddr common.Address) bool {
+		rotatedAddresses = append(rotatedAddresses, &types.RotatedEthereumAddress{ValidatorAddress: val.String(), EthereumAddress: ethAddr.Hex()})
+		return false
+	})
+
+	// export canceled community pool contract calls awaiting a refund
+	k.iterateUnrefundedContractCallTxs(ctx, func(cctx *types.ContractCallTx) bool {
+		unrefundedCalls = append(unrefundedCalls, cctx)
+		return false
+	})
+
+	// export cosmos denom registrations
+	k.iterateCosmosDenomRegistrations(ctx, func(registration *types.CosmosDenomRegistration) bool {
+		registrations = append(registrations, registration)
+		return false
+	})
+
+	// export executed outgoing tx records
+	k.IterateExecutedOutgoingTxs(ctx, func(record *types.ExecutedOutgoingTx) bool {
+		executedTxs = append(executedTxs, record)
+		return false
+	})
+
 	// export signer set txs and sigs
 	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		sstx, _ := otx.(*types.SignerSetTx)
+		checkpoint := sstx.GetCheckpoint(gravityID)
 		k.iterateEthereumSignatures(ctx, sstx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.SignerSetTxConfirmation{sstx.Nonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
+			siga, _ := types.PackConfirmation(&types.SignerSetTxConfirmation{sstx.Nonce, k.ethereumSignatureSigner(ctx, checkpoint, val, sig).Hex(), sig})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
 		})
@@ -138,8 +229,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		btx, _ := otx.(*types.BatchTx)
+		checkpoint := btx.GetCheckpoint(gravityID)
 		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.BatchTxConfirmation{btx.TokenContract, btx.BatchNonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
+			siga, _ := types.PackConfirmation(&types.BatchTxConfirmation{btx.TokenContract, btx.BatchNonce, k.ethereumSignatureSigner(ctx, checkpoint, val, sig).Hex(), sig})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
 		})
@@ -151,8 +243,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		btx, _ := otx.(*types.ContractCallTx)
+		checkpoint := btx.GetCheckpoint(gravityID)
 		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.ContractCallTxConfirmation{btx.InvalidationScope, btx.InvalidationNonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
+			siga, _ := types.PackConfirmation(&types.ContractCallTxConfirmation{btx.InvalidationScope, btx.InvalidationNonce, k.ethereumSignatureSigner(ctx, checkpoint, val, sig).Hex(), sig})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
 		})
@@ -173,5 +266,10 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		DelegateKeys:               delegates,
 		Erc20ToDenoms:              erc20ToDenoms,
 		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+		CosmosDenomRegistrations:   registrations,
+		ExecutedOutgoingTxs:        executedTxs,
+		RetiredErc20ToDenoms:       retiredERC20s,
+		RotatedEthereumAddresses:   rotatedAddresses,
+		UnrefundedContractCallTxs:  unrefundedCalls,
 	}
 }
@@ -5,7 +5,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 // for the moment this is only testing delegate keys being set, but it would be good to make
@@ -34,3 +38,262 @@ func TestExportAndImport(t *testing.T) {
 	assert.Equal(t, newKeeper.GetEthereumOrchestratorAddress(newCtx, ethAddr), orchAddr)
 	assert.Equal(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, orchAddr), valAddr)
 }
+
+func TestExportAndImportEthereumSignatures(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	batchTx := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       1000,
+		TokenContract: TokenContractAddrs[0],
+		Height:        100,
+	}
+	gk.SetOutgoingTx(ctx, batchTx)
+	contractCallTx := &types.ContractCallTx{
+		InvalidationNonce: 1,
+		InvalidationScope: []byte("scope"),
+		Address:           TokenContractAddrs[1],
+		Payload:           []byte("payload"),
+		Timeout:           1000,
+		Height:            100,
+	}
+	gk.SetOutgoingTx(ctx, contractCallTx)
+
+	for i, val := range ValAddrs[:3] {
+		sig := []byte{byte(i + 1)}
+		gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: EthAddrs[i].Hex(),
+			Signature:      sig,
+		}, val)
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+			TokenContract:  batchTx.TokenContract,
+			BatchNonce:     batchTx.BatchNonce,
+			EthereumSigner: EthAddrs[i].Hex(),
+			Signature:      sig,
+		}, val)
+		gk.SetEthereumSignature(ctx, &types.ContractCallTxConfirmation{
+			InvalidationScope: contractCallTx.InvalidationScope,
+			InvalidationNonce: contractCallTx.InvalidationNonce,
+			EthereumSigner:    EthAddrs[i].Hex(),
+			Signature:         sig,
+		}, val)
+	}
+
+	// the fourth validator is rotating its Ethereum key and signs with the
+	// previous one, which only it can prove to be its own
+	rotatedKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	rotatedAddr := crypto.PubkeyToAddress(rotatedKey.PublicKey)
+	gk.setRotatedEthereumAddress(ctx, ValAddrs[3], rotatedAddr)
+
+	gravityID := []byte(gk.getGravityID(ctx))
+	var rotatedConfirmations []types.EthereumTxConfirmation
+	for _, otx := range []types.OutgoingTx{signerSetTx, batchTx, contractCallTx} {
+		sig, err := types.NewEthereumSignature(otx.GetCheckpoint(gravityID), rotatedKey)
+		require.NoError(t, err)
+		var conf types.EthereumTxConfirmation
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			conf = &types.SignerSetTxConfirmation{SignerSetNonce: otx.Nonce, EthereumSigner: rotatedAddr.Hex(), Signature: sig}
+		case *types.BatchTx:
+			conf = &types.BatchTxConfirmation{TokenContract: otx.TokenContract, BatchNonce: otx.BatchNonce, EthereumSigner: rotatedAddr.Hex(), Signature: sig}
+		case *types.ContractCallTx:
+			conf = &types.ContractCallTxConfirmation{InvalidationScope: otx.InvalidationScope, InvalidationNonce: otx.InvalidationNonce, EthereumSigner: rotatedAddr.Hex(), Signature: sig}
+		}
+		gk.SetEthereumSignature(ctx, conf, ValAddrs[3])
+		rotatedConfirmations = append(rotatedConfirmations, conf)
+	}
+
+	exportedGenesis := ExportGenesis(ctx, gk)
+	require.Len(t, exportedGenesis.Confirmations, 12)
+
+	// the rotated key confirmations are exported exactly as they were made
+	exported := make(map[string][]byte)
+	for _, confa := range exportedGenesis.Confirmations {
+		conf, err := types.UnpackConfirmation(confa)
+		require.NoError(t, err)
+		if conf.GetSigner() == rotatedAddr {
+			exported[string(conf.GetStoreIndex())] = confa.Value
+		}
+	}
+	require.Len(t, exported, 3)
+	for _, conf := range rotatedConfirmations {
+		confa, err := types.PackConfirmation(conf)
+		require.NoError(t, err)
+		require.Equal(t, confa.Value, exported[string(conf.GetStoreIndex())])
+	}
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	for _, storeIndex := range [][]byte{signerSetTx.GetStoreIndex(), batchTx.GetStoreIndex(), contractCallTx.GetStoreIndex()} {
+		require.Equal(t, gk.GetEthereumSignatures(ctx, storeIndex), newKeeper.GetEthereumSignatures(newCtx, storeIndex))
+	}
+	require.Equal(t, rotatedAddr, newKeeper.GetSigningEthereumAddress(newCtx, ValAddrs[3]))
+	require.Equal(t, exportedGenesis, ExportGenesis(newCtx, newKeeper))
+
+	// signatures from Ethereum addresses without delegate keys are rejected
+	unknown, err := types.PackConfirmation(&types.BatchTxConfirmation{
+		TokenContract:  batchTx.TokenContract,
+		BatchNonce:     batchTx.BatchNonce,
+		EthereumSigner: common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546").Hex(),
+		Signature:      []byte{1},
+	})
+	require.NoError(t, err)
+	exportedGenesis.Confirmations = append(exportedGenesis.Confirmations, unknown)
+	rejectEnv := CreateTestEnv(t)
+	require.Panics(t, func() {
+		InitGenesis(rejectEnv.Context, rejectEnv.GravityKeeper, exportedGenesis)
+	})
+}
+
+func TestExportAndImportExecutedOutgoingTxs(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	sender, _ := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	tokenContract := common.HexToAddress(TokenContractAddrs[0])
+
+	batchRecord := &types.ExecutedOutgoingTx{
+		StoreIndex:     types.MakeBatchTxKey(tokenContract, 1),
+		Nonce:          1,
+		TokenContracts: []string{tokenContract.Hex()},
+		TxIds:          []uint64{1, 2},
+		Senders:        []string{sender.String()},
+		EthereumHeight: 1000,
+		CosmosHeight:   100,
+	}
+	callRecord := &types.ExecutedOutgoingTx{
+		StoreIndex:     types.MakeContractCallTxKey([]byte("scope"), 1),
+		Nonce:          1,
+		TokenContracts: []string{tokenContract.Hex()},
+		EthereumHeight: 2000,
+		CosmosHeight:   200,
+	}
+	gk.setExecutedOutgoingTx(ctx, batchRecord)
+	gk.setExecutedOutgoingTx(ctx, callRecord)
+
+	exportedGenesis := ExportGenesis(ctx, gk)
+	require.Equal(t, []*types.ExecutedOutgoingTx{batchRecord, callRecord}, exportedGenesis.ExecutedOutgoingTxs)
+	require.NoError(t, exportedGenesis.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.Equal(t, batchRecord, newKeeper.GetExecutedOutgoingTx(newCtx, 100, batchRecord.StoreIndex))
+	require.Equal(t, callRecord, newKeeper.GetExecutedOutgoingTx(newCtx, 200, callRecord.StoreIndex))
+
+	// the token and sender indexes are rebuilt
+	byToken, _, err := newKeeper.PaginateExecutedOutgoingTxsByToken(newCtx, nil, tokenContract)
+	require.NoError(t, err)
+	require.Len(t, byToken, 2)
+	bySender, _, err := newKeeper.PaginateExecutedOutgoingTxsBySender(newCtx, nil, sender)
+	require.NoError(t, err)
+	require.Equal(t, []*types.ExecutedOutgoingTx{batchRecord}, bySender)
+
+	require.Equal(t, exportedGenesis, ExportGenesis(newCtx, newKeeper))
+}
+
+func TestExportAndImportRetiredERC20s(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	retired := &types.ERC20ToDenom{
+		Erc20:        common.HexToAddress(TokenContractAddrs[0]).Hex(),
+		Denom:        "uatom",
+		DecimalShift: 12,
+	}
+	gk.setRetiredCosmosOriginatedERC20(ctx, retired)
+
+	exportedGenesis := ExportGenesis(ctx, gk)
+	require.Equal(t, []*types.ERC20ToDenom{retired}, exportedGenesis.RetiredErc20ToDenoms)
+	require.NoError(t, exportedGenesis.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	InitGenesis(newEnv.Context, newEnv.GravityKeeper, exportedGenesis)
+
+	isCosmosOriginated, denom, decimalShift := newEnv.GravityKeeper.depositDenom(newEnv.Context, common.HexToAddress(retired.Erc20))
+	require.True(t, isCosmosOriginated)
+	require.Equal(t, "uatom", denom)
+	require.EqualValues(t, 12, decimalShift)
+}
+
+func TestExportAndImportRotatedEthereumAddresses(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	rotatedKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	rotatedAddr := crypto.PubkeyToAddress(rotatedKey.PublicKey)
+
+	// the validator rotated to EthAddrs[0] and still signs with its previous key
+	gk.setRotatedEthereumAddress(ctx, ValAddrs[0], rotatedAddr)
+
+	batchTx := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       1000,
+		TokenContract: TokenContractAddrs[0],
+		Height:        100,
+	}
+	gk.SetOutgoingTx(ctx, batchTx)
+	sig, err := types.NewEthereumSignature(batchTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), rotatedKey)
+	require.NoError(t, err)
+	gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+		TokenContract:  batchTx.TokenContract,
+		BatchNonce:     batchTx.BatchNonce,
+		EthereumSigner: rotatedAddr.Hex(),
+		Signature:      sig,
+	}, ValAddrs[0])
+
+	exportedGenesis := ExportGenesis(ctx, gk)
+	require.Equal(t, []*types.RotatedEthereumAddress{{ValidatorAddress: ValAddrs[0].String(), EthereumAddress: rotatedAddr.Hex()}}, exportedGenesis.RotatedEthereumAddresses)
+	require.NoError(t, exportedGenesis.ValidateBasic())
+
+	// the signature is exported with the key that made it
+	require.Len(t, exportedGenesis.Confirmations, 1)
+	conf, err := types.UnpackConfirmation(exportedGenesis.Confirmations[0])
+	require.NoError(t, err)
+	require.Equal(t, rotatedAddr, conf.GetSigner())
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.Equal(t, rotatedAddr, newKeeper.GetSigningEthereumAddress(newCtx, ValAddrs[0]))
+	require.Equal(t, EthAddrs[0], newKeeper.GetValidatorEthereumAddress(newCtx, ValAddrs[0]))
+	require.Equal(t, sig, newKeeper.getEthereumSignature(newCtx, batchTx.GetStoreIndex(), ValAddrs[0]))
+}
+
+func TestExportAndImportUnrefundedContractCallTxs(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	cctx := &types.ContractCallTx{
+		InvalidationNonce: 1,
+		InvalidationScope: types.CommunityPoolContractCallScope,
+		Address:           EthAddrs[0].Hex(),
+		Payload:           []byte("deposit"),
+		Timeout:           1000,
+		Tokens:            []types.ERC20Token{types.NewERC20Token(100, common.HexToAddress(TokenContractAddrs[0]))},
+	}
+	gk.setUnrefundedContractCallTx(ctx, cctx)
+
+	exportedGenesis := ExportGenesis(ctx, gk)
+	require.Equal(t, []*types.ContractCallTx{cctx}, exportedGenesis.UnrefundedContractCallTxs)
+	require.NoError(t, exportedGenesis.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	InitGenesis(newEnv.Context, newEnv.GravityKeeper, exportedGenesis)
+	require.Equal(t, cctx, newEnv.GravityKeeper.getUnrefundedContractCallTx(newEnv.Context, 1))
+}
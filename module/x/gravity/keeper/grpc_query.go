@@ -317,21 +317,33 @@ func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20Par
 		)
 	}
 
+	// the ERC20 decimals are shifted from the denom's if governance registered it so
+	var decimalShift int64
+	if registration, ok := k.GetCosmosDenomRegistration(ctx, req.Denom); ok {
+		decimalShift = int64(registration.DecimalShift)
+	}
+
 	// use metadata, if we can find it
 	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, req.Denom); ok && md.Base != "" {
-		var erc20Decimals uint64
+		var decimals int64
 		for _, denomUnit := range md.DenomUnits {
 			if denomUnit.Denom == md.Display {
-				erc20Decimals = uint64(denomUnit.Exponent)
+				decimals = int64(denomUnit.Exponent)
 				break
 			}
 		}
+		if decimals+decimalShift < 0 {
+			return nil, sdkerrors.Wrapf(
+				types.ErrInvalidERC20Event,
+				"denom %s has %d decimals, less than the registered decimal shift %d", req.Denom, decimals, decimalShift,
+			)
+		}
 
 		return &types.DenomToERC20ParamsResponse{
 			BaseDenom:     md.Base,
 			Erc20Name:     md.Display,
 			Erc20Symbol:   md.Display,
-			Erc20Decimals: erc20Decimals,
+			Erc20Decimals: uint64(decimals + decimalShift),
 		}, nil
 	}
 
@@ -342,12 +354,19 @@ func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20Par
 		)
 	}
 
-	// no metadata, go with a zero decimal, no symbol erc-20
+	if decimalShift < 0 {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidERC20Event,
+			"negative decimal shift %d registered for token %s without metadata", decimalShift, req.Denom,
+		)
+	}
+
+	// no metadata, go with a zero decimal, no symbol erc-20, unless shifted
 	res := &types.DenomToERC20ParamsResponse{
 		BaseDenom:     req.Denom,
 		Erc20Name:     req.Denom,
 		Erc20Symbol:   "",
-		Erc20Decimals: 0,
+		Erc20Decimals: uint64(decimalShift),
 	}
 
 	return res, nil
@@ -428,7 +447,7 @@ func (k Keeper) DelegateKeysByEthereumSigner(c context.Context, req *types.Deleg
 	}
 	ethAddr := common.HexToAddress(req.EthereumSigner)
 	orchAddr := k.GetEthereumOrchestratorAddress(ctx, ethAddr)
-	valAddr := k.GetOrchestratorValidatorAddress(ctx, orchAddr)
+	valAddr := k.GetEthereumValidatorAddress(ctx, ethAddr)
 	res := &types.DelegateKeysByEthereumSignerResponse{
 		ValidatorAddress:    valAddr.String(),
 		OrchestratorAddress: orchAddr.String(),
@@ -471,3 +490,161 @@ func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObs
 
 	return res, nil
 }
+
+func (k Keeper) SignerSetCoverage(c context.Context, req *types.SignerSetCoverageRequest) (*types.SignerSetCoverageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	coverage, representedPower, totalPower, missing := k.signerSetCoverage(ctx)
+
+	res := &types.SignerSetCoverageResponse{
+		Coverage:                      coverage,
+		RepresentedPower:              representedPower,
+		TotalPower:                    totalPower,
+		ValidatorsMissingDelegateKeys: missing,
+	}
+
+	return res, nil
+}
+
+func (k Keeper) OutgoingTxSignedPower(c context.Context, req *types.OutgoingTxSignedPowerRequest) (*types.OutgoingTxSignedPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	otx := k.GetOutgoingTx(ctx, req.StoreIndex)
+	if otx == nil {
+		return nil, status.Errorf(codes.NotFound, "no outgoing tx found for %x", req.StoreIndex)
+	}
+
+	signerSet, signedPower, unsigned := k.signedPowerOnEthereum(ctx, otx)
+	if signerSet == nil {
+		return nil, status.Errorf(codes.NotFound, "no observed signer set")
+	}
+
+	res := &types.OutgoingTxSignedPowerResponse{
+		SignerSetNonce: signerSet.Nonce,
+		SignedPower:    signedPower,
+		TotalPower:     types.EthereumSigners(signerSet.Signers).TotalPower(),
+		PowerThreshold: types.EthereumSignerSetPowerThreshold,
+		ThresholdMet:   signedPower > types.EthereumSignerSetPowerThreshold,
+	}
+
+	for _, signer := range unsigned {
+		missing := &types.MissingEthereumSigner{
+			EthereumAddress: signer.EthereumAddress,
+			Power:           signer.Power,
+		}
+		if val := k.GetEthereumValidatorAddress(ctx, common.HexToAddress(signer.EthereumAddress)); val != nil {
+			missing.ValidatorAddress = val.String()
+		}
+		res.MissingSigners = append(res.MissingSigners, missing)
+	}
+
+	return res, nil
+}
+
+// OutgoingTxSignatureStatus reports the same signature progress as
+// OutgoingTxSignedPower, for clients of the relay readiness query
+func (k Keeper) OutgoingTxSignatureStatus(c context.Context, req *types.OutgoingTxSignatureStatusRequest) (*types.OutgoingTxSignatureStatusResponse, error) {
+	res, err := k.OutgoingTxSignedPower(c, &types.OutgoingTxSignedPowerRequest{StoreIndex: req.StoreIndex})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OutgoingTxSignatureStatusResponse{
+		SignerSetNonce: res.SignerSetNonce,
+		SignedPower:    res.SignedPower,
+		TotalPower:     res.TotalPower,
+		PowerThreshold: res.PowerThreshold,
+		ReadyToRelay:   res.ThresholdMet,
+		MissingSigners: res.MissingSigners,
+	}, nil
+}
+
+func (k Keeper) OutgoingTxRelayCalldata(c context.Context, req *types.OutgoingTxRelayCalldataRequest) (*types.OutgoingTxRelayCalldataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	otx := k.GetOutgoingTx(ctx, req.StoreIndex)
+	if otx == nil {
+		return nil, status.Errorf(codes.NotFound, "no outgoing tx found for %x", req.StoreIndex)
+	}
+
+	signerSet := k.GetLastObservedSignerSetTx(ctx)
+	if signerSet == nil {
+		return nil, status.Errorf(codes.NotFound, "no observed signer set")
+	}
+
+	// the contract holds the signer set in the sorted order it was checkpointed in
+	signers := append(types.EthereumSigners{}, signerSet.Signers...)
+	signers.Sort()
+
+	sigs, signedPower := k.relaySignatures(ctx, otx, signers)
+	if signedPower <= types.EthereumSignerSetPowerThreshold {
+		return nil, status.Errorf(codes.FailedPrecondition, "signed power %d does not exceed threshold %d", signedPower, types.EthereumSignerSetPowerThreshold)
+	}
+
+	calldata, err := otx.GetRelayCalldata(signerSet, sigs)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	res := &types.OutgoingTxRelayCalldataResponse{
+		GravityContractAddress: k.getBridgeContractAddress(ctx),
+		SignerSetNonce:         signerSet.Nonce,
+		Calldata:               calldata,
+	}
+
+	return res, nil
+}
+
+func (k Keeper) ExecutedOutgoingTxsByToken(c context.Context, req *types.ExecutedOutgoingTxsByTokenRequest) (*types.ExecutedOutgoingTxsByTokenResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
+	records, pageRes, err := k.PaginateExecutedOutgoingTxsByToken(sdk.UnwrapSDKContext(c), req.Pagination, common.HexToAddress(req.TokenContract))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ExecutedOutgoingTxsByTokenResponse{ExecutedTxs: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ExecutedOutgoingTxsBySender(c context.Context, req *types.ExecutedOutgoingTxsBySenderRequest) (*types.ExecutedOutgoingTxsBySenderResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s", req.SenderAddress)
+	}
+
+	records, pageRes, err := k.PaginateExecutedOutgoingTxsBySender(sdk.UnwrapSDKContext(c), req.Pagination, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ExecutedOutgoingTxsBySenderResponse{ExecutedTxs: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BridgedToken(c context.Context, req *types.BridgedTokenRequest) (*types.BridgedTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denom := req.Denom
+	if denom == "" {
+		if !common.IsHexAddress(req.Erc20) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.Erc20)
+		}
+		_, denom = k.ERC20ToDenomLookup(ctx, common.HexToAddress(req.Erc20))
+	}
+
+	token, err := k.GetBridgedToken(ctx, denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.BridgedTokenResponse{Token: token}, nil
+}
+
+func (k Keeper) BridgedTokens(c context.Context, req *types.BridgedTokensRequest) (*types.BridgedTokensResponse, error) {
+	tokens, pageRes, err := k.PaginateBridgedTokens(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.BridgedTokensResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
@@ -1,12 +1,22 @@
 package keeper
 
 import (
+	"crypto/ecdsa"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/bytes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestKeeper_Params(t *testing.T) {
@@ -234,3 +244,318 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 // DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
 // DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
 // DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+
+func TestKeeper_SignerSetCoverage(t *testing.T) {
+	t.Run("all bonded validators have delegate keys", func(t *testing.T) {
+		input, ctx := SetupFiveValChain(t)
+		gk := input.GravityKeeper
+
+		res, err := gk.SignerSetCoverage(sdk.WrapSDKContext(ctx), &types.SignerSetCoverageRequest{})
+		require.NoError(t, err)
+		require.Equal(t, sdk.OneDec(), res.Coverage)
+		require.Equal(t, res.TotalPower, res.RepresentedPower)
+		require.Empty(t, res.ValidatorsMissingDelegateKeys)
+	})
+	t.Run("a bonded validator is missing delegate keys", func(t *testing.T) {
+		input, ctx := SetupFiveValChain(t)
+		gk := input.GravityKeeper
+		ctx.KVStore(gk.storeKey).Delete(types.MakeValidatorEthereumAddressKey(ValAddrs[4]))
+
+		res, err := gk.SignerSetCoverage(sdk.WrapSDKContext(ctx), &types.SignerSetCoverageRequest{})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecWithPrec(8, 1), res.Coverage)
+		require.Equal(t, res.TotalPower*4/5, res.RepresentedPower)
+		require.Equal(t, []string{ValAddrs[4].String()}, res.ValidatorsMissingDelegateKeys)
+		require.Len(t, gk.CurrentSignerSet(ctx), 4)
+
+		// the signer set tx is still created, but with a warning event
+		gk.CreateSignerSetTx(ctx)
+		var warnings []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSignerSetCoverageWarning {
+				warnings = append(warnings, event)
+			}
+		}
+		require.Len(t, warnings, 1)
+		require.Contains(t, warnings[0].Attributes, abci.EventAttribute{
+			Key:   []byte(types.AttributeKeyValidatorsMissingDelegateKeys),
+			Value: []byte(ValAddrs[4].String()),
+		})
+	})
+}
+
+func TestKeeper_OutgoingTxSignedPower(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	batch := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       1000,
+		TokenContract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4",
+		Height:        100,
+	}
+	req := &types.OutgoingTxSignedPowerRequest{StoreIndex: batch.GetStoreIndex()}
+
+	_, err := gk.OutgoingTxSignedPower(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err, "outgoing tx not found")
+
+	gk.SetOutgoingTx(ctx, batch)
+	_, err = gk.OutgoingTxSignedPower(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err, "no observed signer set")
+
+	keys := make([]*ecdsa.PrivateKey, 4)
+	signers := make(types.EthereumSigners, 4)
+	for i := range keys {
+		keys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		signers[i] = &types.EthereumSigner{
+			EthereumAddress: crypto.PubkeyToAddress(keys[i].PublicKey).Hex(),
+			Power:           1_000_000_000,
+		}
+	}
+	signers[3].Power = 1_294_967_295
+	gk.setLastObservedSignerSetTx(ctx, types.SignerSetTx{Nonce: 3, Signers: signers})
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[4], crypto.PubkeyToAddress(keys[3].PublicKey))
+
+	checkpoint := batch.GetCheckpoint([]byte(gk.getGravityID(ctx)))
+	sign := func(key *ecdsa.PrivateKey, val sdk.ValAddress) {
+		sig, err := types.NewEthereumSignature(checkpoint, key)
+		require.NoError(t, err)
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+			TokenContract: batch.TokenContract,
+			BatchNonce:    batch.BatchNonce,
+			Signature:     sig,
+		}, val)
+	}
+
+	sign(keys[0], ValAddrs[0])
+	sign(keys[1], ValAddrs[1])
+	// the same signer submitted by another validator only counts once
+	sign(keys[0], ValAddrs[2])
+	// signers outside the observed set carry no power
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sign(outsider, ValAddrs[3])
+
+	res, err := gk.OutgoingTxSignedPower(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, &types.OutgoingTxSignedPowerResponse{
+		SignerSetNonce: 3,
+		SignedPower:    2_000_000_000,
+		TotalPower:     4_294_967_295,
+		PowerThreshold: types.EthereumSignerSetPowerThreshold,
+		ThresholdMet:   false,
+		MissingSigners: []*types.MissingEthereumSigner{
+			{
+				EthereumAddress: signers[2].EthereumAddress,
+				Power:           1_000_000_000,
+			},
+			{
+				EthereumAddress:  signers[3].EthereumAddress,
+				Power:            1_294_967_295,
+				ValidatorAddress: ValAddrs[4].String(),
+			},
+		},
+	}, res)
+
+	// signers are matched by the recovered Ethereum address, so a validator
+	// that has since rotated its keys is still credited
+	sign(keys[3], ValAddrs[4])
+
+	res, err = gk.OutgoingTxSignedPower(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.EqualValues(t, 3_294_967_295, res.SignedPower)
+	require.True(t, res.ThresholdMet)
+	require.Len(t, res.MissingSigners, 1)
+	require.Equal(t, signers[2].EthereumAddress, res.MissingSigners[0].EthereumAddress)
+
+	// the signature status reports the same progress
+	statusRes, err := gk.OutgoingTxSignatureStatus(sdk.WrapSDKContext(ctx), &types.OutgoingTxSignatureStatusRequest{StoreIndex: req.StoreIndex})
+	require.NoError(t, err)
+	require.Equal(t, &types.OutgoingTxSignatureStatusResponse{
+		SignerSetNonce: res.SignerSetNonce,
+		SignedPower:    res.SignedPower,
+		TotalPower:     res.TotalPower,
+		PowerThreshold: res.PowerThreshold,
+		ReadyToRelay:   true,
+		MissingSigners: res.MissingSigners,
+	}, statusRes)
+}
+
+func TestKeeper_OutgoingTxRelayCalldata(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	batch := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       1000,
+		TokenContract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4",
+		Height:        100,
+	}
+	req := &types.OutgoingTxRelayCalldataRequest{StoreIndex: batch.GetStoreIndex()}
+
+	_, err := gk.OutgoingTxRelayCalldata(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err, "outgoing tx not found")
+
+	gk.SetOutgoingTx(ctx, batch)
+	_, err = gk.OutgoingTxRelayCalldata(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err, "no observed signer set")
+
+	keys := make([]*ecdsa.PrivateKey, 3)
+	signers := make(types.EthereumSigners, 3)
+	for i := range keys {
+		keys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		signers[i] = &types.EthereumSigner{
+			EthereumAddress: crypto.PubkeyToAddress(keys[i].PublicKey).Hex(),
+		}
+	}
+	signers[0].Power = 800_000_000
+	signers[1].Power = 1_500_000_000
+	signers[2].Power = 1_994_967_295
+	gk.setLastObservedSignerSetTx(ctx, types.SignerSetTx{Nonce: 4, Signers: signers})
+
+	checkpoint := batch.GetCheckpoint([]byte(gk.getGravityID(ctx)))
+	sign := func(key *ecdsa.PrivateKey, val sdk.ValAddress) []byte {
+		sig, err := types.NewEthereumSignature(checkpoint, key)
+		require.NoError(t, err)
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+			TokenContract: batch.TokenContract,
+			BatchNonce:    batch.BatchNonce,
+			Signature:     sig,
+		}, val)
+		return sig
+	}
+
+	sign(keys[2], ValAddrs[0])
+	_, err = gk.OutgoingTxRelayCalldata(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err, "not enough power has signed")
+
+	sig := sign(keys[1], ValAddrs[1])
+	res, err := gk.OutgoingTxRelayCalldata(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, gk.getBridgeContractAddress(ctx), res.GravityContractAddress)
+	require.EqualValues(t, 4, res.SignerSetNonce)
+
+	gravityABI, err := abi.JSON(strings.NewReader(types.GravityABIJSON))
+	require.NoError(t, err)
+	method := gravityABI.Methods["submitBatch"]
+	require.Equal(t, method.ID, res.Calldata[:4])
+	args, err := method.Inputs.Unpack(res.Calldata[4:])
+	require.NoError(t, err)
+
+	// signatures follow the signer set sorted by power, and the signer that
+	// has not signed gets an empty signature
+	sigs := args[1].([]struct {
+		V uint8    `json:"v"`
+		R [32]byte `json:"r"`
+		S [32]byte `json:"s"`
+	})
+	require.Len(t, sigs, 3)
+	require.NotZero(t, sigs[0].V)
+	require.Equal(t, sig[:32], sigs[1].R[:])
+	require.Equal(t, sig[32:64], sigs[1].S[:])
+	require.Equal(t, sig[64]+27, sigs[1].V)
+	require.Zero(t, sigs[2].V)
+}
+
+func TestKeeper_BridgedTokens(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	var (
+		atomERC20    = gethcommon.HexToAddress("0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e")
+		voucherERC20 = gethcommon.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		voucher      = types.GravityDenom(voucherERC20)
+		user         = sdk.AccAddress([]byte("bridged-token-holder"))
+	)
+
+	env.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	})
+	gk.setCosmosOriginatedDenomToERC20(ctx, "uatom", atomERC20)
+
+	// 100uatom exist on Cosmos, 40 of which are locked in the module
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin(voucher, 50))))
+	require.NoError(t, env.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, user, sdk.NewCoins(sdk.NewInt64Coin("uatom", 60), sdk.NewInt64Coin(voucher, 50))))
+
+	// a Cosmos denom without an ERC20 isn't bridged
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 10))))
+
+	expAtom := types.BridgedToken{
+		Denom:            "uatom",
+		Erc20:            atomERC20.Hex(),
+		CosmosOriginated: true,
+		Decimals:         6,
+		Display:          "atom",
+		TotalSupply:      sdk.NewInt(100),
+		LockedAmount:     sdk.NewInt(40),
+	}
+	expVoucher := types.BridgedToken{
+		Denom:        voucher,
+		Erc20:        voucherERC20.Hex(),
+		TotalSupply:  sdk.NewInt(50),
+		LockedAmount: sdk.ZeroInt(),
+	}
+
+	res, err := gk.BridgedToken(sdk.WrapSDKContext(ctx), &types.BridgedTokenRequest{Denom: "uatom"})
+	require.NoError(t, err)
+	require.Equal(t, expAtom, res.Token)
+
+	res, err = gk.BridgedToken(sdk.WrapSDKContext(ctx), &types.BridgedTokenRequest{Erc20: atomERC20.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, expAtom, res.Token)
+
+	res, err = gk.BridgedToken(sdk.WrapSDKContext(ctx), &types.BridgedTokenRequest{Erc20: voucherERC20.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, expVoucher, res.Token)
+
+	_, err = gk.BridgedToken(sdk.WrapSDKContext(ctx), &types.BridgedTokenRequest{Denom: "ufoo"})
+	require.Error(t, err)
+
+	// an ERC20 that was never deposited has no voucher
+	_, err = gk.BridgedToken(sdk.WrapSDKContext(ctx), &types.BridgedTokenRequest{Erc20: "0x2a24af0501a534fca004ee1bd667b783f205a546"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// tokens of both origins are listed, paged in denom order
+	osmoERC20 := gethcommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	gk.setCosmosOriginatedDenomToERC20(ctx, "uosmo", osmoERC20)
+	expOsmo := types.BridgedToken{
+		Denom:            "uosmo",
+		Erc20:            osmoERC20.Hex(),
+		CosmosOriginated: true,
+		TotalSupply:      sdk.ZeroInt(),
+		LockedAmount:     sdk.ZeroInt(),
+	}
+
+	page, err := gk.BridgedTokens(sdk.WrapSDKContext(ctx), &types.BridgedTokensRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgedToken{expVoucher}, page.Tokens)
+	require.EqualValues(t, 3, page.Pagination.Total)
+
+	page, err = gk.BridgedTokens(sdk.WrapSDKContext(ctx), &types.BridgedTokensRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgedToken{expAtom}, page.Tokens)
+
+	page, err = gk.BridgedTokens(sdk.WrapSDKContext(ctx), &types.BridgedTokensRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgedToken{expOsmo}, page.Tokens)
+	require.Nil(t, page.Pagination.NextKey)
+
+	page, err = gk.BridgedTokens(sdk.WrapSDKContext(ctx), &types.BridgedTokensRequest{Pagination: &query.PageRequest{Offset: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgedToken{expAtom, expOsmo}, page.Tokens)
+
+	// vouchers whose supply was burned are no longer listed
+	require.NoError(t, env.BankKeeper.SendCoinsFromAccountToModule(ctx, user, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(voucher, 50))))
+	require.NoError(t, env.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(voucher, 50))))
+	page, err = gk.BridgedTokens(sdk.WrapSDKContext(ctx), &types.BridgedTokensRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgedToken{expAtom, expOsmo}, page.Tokens)
+}
@@ -6,14 +6,17 @@ import (
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
+// Hooks wraps the gravity keeper to implement the staking hooks. It holds a
+// pointer so that the hooks observe the keeper as it is wired up in the app
+// rather than a copy taken before the staking hooks were set.
 type Hooks struct {
-	k Keeper
+	k *Keeper
 }
 
 var _ stakingtypes.StakingHooks = Hooks{}
 
 // Hooks Create new gravity hooks
-func (k Keeper) Hooks() Hooks { return Hooks{k} }
+func (k *Keeper) Hooks() Hooks { return Hooks{k} }
 
 func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
 
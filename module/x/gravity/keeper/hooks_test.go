@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooks_ValidatorBeginUnbonding(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	require.Zero(t, gk.GetLastUnbondingBlockHeight(ctx))
+	require.Len(t, gk.CurrentSignerSet(ctx), 5)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(ctx, NewTestMsgUnDelegateValidator(ValAddrs[0], StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	validator, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	require.True(t, validator.IsUnbonding())
+	require.EqualValues(t, ctx.BlockHeight(), gk.GetLastUnbondingBlockHeight(ctx))
+	require.Len(t, gk.CurrentSignerSet(ctx), 4)
+}
+
+func TestHooks_ValidatorRemoved(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	unbondingHeight := ctx.BlockHeight() + 1
+	ctx = ctx.WithBlockHeight(unbondingHeight)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(ctx, NewTestMsgUnDelegateValidator(ValAddrs[0], StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// once the unbonding period has passed, the validator without any
+	// delegations left is removed from the staking store
+	ctx = ctx.
+		WithBlockHeight(unbondingHeight + 1).
+		WithBlockTime(ctx.BlockTime().Add(TestingStakeParams.UnbondingTime + time.Second))
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	_, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.False(t, found)
+
+	// removal is not a new unbonding and must not move the unbonding height
+	require.EqualValues(t, unbondingHeight, gk.GetLastUnbondingBlockHeight(ctx))
+	require.Len(t, gk.CurrentSignerSet(ctx), 4)
+}
+
+func TestHooks_ValidatorSlashedAndJailed(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	validator, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	// slashing through the gravity keeper's staking keeper must run the
+	// staking hooks, so that distribution records the slash
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	power := input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0])
+	gk.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, TestingGravityParams.SlashFractionSignerSetTx)
+
+	var slashEvents int
+	input.DistKeeper.IterateValidatorSlashEventsBetween(ctx, ValAddrs[0], 0, uint64(ctx.BlockHeight()),
+		func(height uint64, event distrtypes.ValidatorSlashEvent) bool {
+			slashEvents++
+			return false
+		},
+	)
+	require.Equal(t, 1, slashEvents)
+
+	// jailing removes the validator from the active set at the end of the
+	// block, which starts its unbonding
+	gk.StakingKeeper.Jail(ctx, consAddr)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	validator, found = input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.IsUnbonding())
+	require.EqualValues(t, ctx.BlockHeight(), gk.GetLastUnbondingBlockHeight(ctx))
+	require.Len(t, gk.CurrentSignerSet(ctx), 4)
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// IBCForwardTimeout is how long the IBC transfer of a forwarded deposit has to
+// be received on the destination chain before it times out and is refunded
+const IBCForwardTimeout = 10 * time.Minute
+
+// SetTransferKeeper sets the ICS-20 transfer keeper deposits are forwarded
+// over IBC with
+func (k *Keeper) SetTransferKeeper(tk types.TransferKeeper) *Keeper {
+	if k.transferKeeper != nil {
+		panic("cannot set gravity transfer keeper twice")
+	}
+
+	k.transferKeeper = tk
+
+	return k
+}
+
+// forwardOverIBC transfers the coin received from a deposit on to another
+// chain, as given by the IBC forward encoded in the cosmos receiver of the
+// deposit. The transfer is sent from the local address that received the
+// coin, so the transfer module refunds it if the packet times out or is
+// acknowledged with an error. If the forward is malformed or the transfer
+// can't be sent at all, the coin simply stays with the local address.
+func (k Keeper) forwardOverIBC(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, encodedForward string) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyIBCForwardSender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyIBCForwardAmount, coin.String()),
+	}
+
+	forward, err := types.ParseIBCForward(encodedForward)
+	if err == nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyIBCForwardPort, forward.Port),
+			sdk.NewAttribute(types.AttributeKeyIBCForwardChannel, forward.Channel),
+			sdk.NewAttribute(types.AttributeKeyIBCForwardReceiver, forward.Receiver),
+		)
+		err = k.sendIBCForward(ctx, sender, coin, forward)
+		if err == nil {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCForward, attributes...))
+			return
+		}
+	}
+
+	k.Logger(ctx).Error("deposit IBC forward failed, coins left with the local address",
+		"address", sender.String(), "amount", coin.String(), "forward", encodedForward, "error", err)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeIBCForwardFailed,
+		append(attributes, sdk.NewAttribute(types.AttributeKeyIBCForwardError, err.Error()))...,
+	))
+}
+
+func (k Keeper) sendIBCForward(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, forward *types.IBCForward) error {
+	if k.transferKeeper == nil {
+		return types.ErrIBCForwardingDisabled
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	timeout := uint64(ctx.BlockTime().Add(IBCForwardTimeout).UnixNano())
+	if err := k.transferKeeper.SendTransfer(cacheCtx, forward.Port, forward.Channel, coin, sender, forward.Receiver, clienttypes.ZeroHeight(), timeout); err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
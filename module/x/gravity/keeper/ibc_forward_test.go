@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+type sentTransfer struct {
+	port, channel    string
+	token            sdk.Coin
+	sender           sdk.AccAddress
+	receiver         string
+	timeoutTimestamp uint64
+}
+
+// mockTransferKeeper escrows transferred coins like the transfer module does
+// for coins that leave the chain, and refunds them on a simulated timeout
+type mockTransferKeeper struct {
+	bankKeeper bankkeeper.BaseKeeper
+	escrow     sdk.AccAddress
+	channels   map[string]bool
+	transfers  []sentTransfer
+}
+
+func (m *mockTransferKeeper) SendTransfer(ctx sdk.Context, sourcePort, sourceChannel string, token sdk.Coin, sender sdk.AccAddress, receiver string, _ clienttypes.Height, timeoutTimestamp uint64) error {
+	if !m.channels[sourceChannel] {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+	if err := m.bankKeeper.SendCoins(ctx, sender, m.escrow, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+	m.transfers = append(m.transfers, sentTransfer{sourcePort, sourceChannel, token, sender, receiver, timeoutTimestamp})
+	return nil
+}
+
+// timeout refunds the sender of a transfer, as the transfer module does when
+// its packet times out or is acknowledged with an error
+func (m *mockTransferKeeper) timeout(ctx sdk.Context, transfer sentTransfer) error {
+	return m.bankKeeper.SendCoins(ctx, m.escrow, transfer.sender, sdk.NewCoins(transfer.token))
+}
+
+func TestSendToCosmosEventIBCForward(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	fallback := sdk.AccAddress([]byte("fallback-receiver-ad"))
+	coin := sdk.NewCoin(types.GravityDenom(tokenContract), sdk.NewInt(100))
+	nonce := uint64(0)
+	deposit := func(cosmosReceiver string) *types.SendToCosmosEvent {
+		nonce++
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		event := &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract.Hex(),
+			Amount:         coin.Amount,
+			EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			CosmosReceiver: cosmosReceiver,
+			EthereumHeight: 10,
+		}
+		require.NoError(t, event.Validate())
+		require.NoError(t, k.Handle(ctx, event))
+		return event
+	}
+	lastEventType := func() string {
+		events := ctx.EventManager().Events()
+		return events[len(events)-1].Type
+	}
+	forwardTo := fallback.String() + "|transfer/channel-0:osmo1receiver"
+
+	// without a transfer keeper the coins stay with the fallback address
+	deposit(forwardTo)
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, fallback, coin.Denom))
+	require.Equal(t, types.EventTypeIBCForwardFailed, lastEventType())
+
+	transferKeeper := &mockTransferKeeper{
+		bankKeeper: input.BankKeeper,
+		escrow:     sdk.AccAddress([]byte("ibc-transfer-escrow-")),
+		channels:   map[string]bool{"channel-0": true},
+	}
+	k.SetTransferKeeper(transferKeeper)
+	require.Panics(t, func() { k.SetTransferKeeper(transferKeeper) })
+
+	// the minted coins are transferred on from the fallback address
+	deposit(forwardTo)
+	require.Equal(t, []sentTransfer{{
+		port:             "transfer",
+		channel:          "channel-0",
+		token:            coin,
+		sender:           fallback,
+		receiver:         "osmo1receiver",
+		timeoutTimestamp: uint64(ctx.BlockTime().Add(IBCForwardTimeout).UnixNano()),
+	}}, transferKeeper.transfers)
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, fallback, coin.Denom))
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, transferKeeper.escrow, coin.Denom))
+	require.Equal(t, types.EventTypeIBCForward, lastEventType())
+
+	// a transfer that times out is refunded to the fallback address
+	require.NoError(t, transferKeeper.timeout(ctx, transferKeeper.transfers[0]))
+	require.Equal(t, coin.Add(coin), input.BankKeeper.GetBalance(ctx, fallback, coin.Denom))
+	require.True(t, input.BankKeeper.GetBalance(ctx, transferKeeper.escrow, coin.Denom).IsZero())
+
+	// a transfer over an unknown channel leaves the coins with the fallback address
+	deposit(fallback.String() + "|transfer/channel-9:osmo1receiver")
+	require.Len(t, transferKeeper.transfers, 1)
+	require.Equal(t, sdk.NewCoin(coin.Denom, sdk.NewInt(300)), input.BankKeeper.GetBalance(ctx, fallback, coin.Denom))
+	require.Equal(t, types.EventTypeIBCForwardFailed, lastEventType())
+
+	// as does a malformed forward, which is not rejected with the event
+	deposit(fallback.String() + "|transfer/not a channel:osmo1receiver")
+	require.Len(t, transferKeeper.transfers, 1)
+	require.Equal(t, sdk.NewCoin(coin.Denom, sdk.NewInt(400)), input.BankKeeper.GetBalance(ctx, fallback, coin.Denom))
+	require.Equal(t, types.EventTypeIBCForwardFailed, lastEventType())
+
+	// the forward is part of what orchestrators attest to
+	event := &types.SendToCosmosEvent{CosmosReceiver: forwardTo, Amount: coin.Amount}
+	withForwardHash := event.Hash()
+	event.CosmosReceiver = fallback.String()
+	require.NotEqual(t, withForwardHash, event.Hash())
+
+	// the fallback address must be a local address
+	event = &types.SendToCosmosEvent{
+		EventNonce:     nonce + 1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         coin.Amount,
+		EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		CosmosReceiver: "osmo1receiver|transfer/channel-0:osmo1receiver",
+	}
+	require.Error(t, event.Validate())
+}
@@ -25,18 +25,18 @@ import (
 
 // Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	StakingKeeper          types.StakingKeeper
-	storeKey               sdk.StoreKey
-	paramSpace             paramtypes.Subspace
-	cdc                    codec.Codec
-	accountKeeper          types.AccountKeeper
-	bankKeeper             types.BankKeeper
-	SlashingKeeper         types.SlashingKeeper
-	DistributionKeeper     types.DistributionKeeper
-	PowerReduction         sdk.Int
-	hooks                  types.GravityHooks
-	ReceiverModuleAccounts map[string]string
-	SenderModuleAccounts   map[string]string
+	StakingKeeper      types.StakingKeeper
+	storeKey           sdk.StoreKey
+	paramSpace         paramtypes.Subspace
+	cdc                codec.Codec
+	accountKeeper      types.AccountKeeper
+	bankKeeper         types.BankKeeper
+	SlashingKeeper     types.SlashingKeeper
+	DistributionKeeper types.DistributionKeeper
+	PowerReduction     sdk.Int
+	hooks              types.GravityHooks
+	transferKeeper     types.TransferKeeper
+	receivingModules   map[string]receivingModule
 }
 
 // NewKeeper returns a new instance of the gravity keeper
@@ -50,8 +50,6 @@ func NewKeeper(
 	slashingKeeper types.SlashingKeeper,
 	distributionKeeper types.DistributionKeeper,
 	powerReduction sdk.Int,
-	receiverModuleAccounts map[string]string,
-	senderModuleAccounts map[string]string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -59,17 +57,16 @@ func NewKeeper(
 	}
 
 	k := Keeper{
-		cdc:                    cdc,
-		paramSpace:             paramSpace,
-		storeKey:               storeKey,
-		accountKeeper:          accKeeper,
-		StakingKeeper:          stakingKeeper,
-		bankKeeper:             bankKeeper,
-		SlashingKeeper:         slashingKeeper,
-		DistributionKeeper:     distributionKeeper,
-		PowerReduction:         powerReduction,
-		ReceiverModuleAccounts: receiverModuleAccounts,
-		SenderModuleAccounts:   senderModuleAccounts,
+		cdc:                cdc,
+		paramSpace:         paramSpace,
+		storeKey:           storeKey,
+		accountKeeper:      accKeeper,
+		StakingKeeper:      stakingKeeper,
+		bankKeeper:         bankKeeper,
+		SlashingKeeper:     slashingKeeper,
+		DistributionKeeper: distributionKeeper,
+		PowerReduction:     powerReduction,
+		receivingModules:   make(map[string]receivingModule),
 	}
 
 	return k
@@ -186,16 +183,28 @@ func (k Keeper) GetOrchestratorValidatorAddress(ctx sdk.Context, orchAddr sdk.Ac
 	return store.Get(key)
 }
 
+// deleteOrchestratorValidatorAddress deletes the validator mapping of an
+// orchestrator key
+func (k Keeper) deleteOrchestratorValidatorAddress(ctx sdk.Context, orchAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeOrchestratorValidatorAddressKey(orchAddr))
+}
+
 ////////////////////////
 // VAL -> ETH ADDRESS //
 ////////////////////////
 
 // setValidatorEthereumAddress sets the ethereum address for a given validator
+// and its reverse index, removing the reverse index of any previous address
 func (k Keeper) setValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.MakeValidatorEthereumAddressKey(valAddr)
 
+	if prev := store.Get(key); prev != nil {
+		store.Delete(types.MakeEthereumValidatorAddressKey(common.BytesToAddress(prev)))
+	}
+
 	store.Set(key, ethAddr.Bytes())
+	store.Set(types.MakeEthereumValidatorAddressKey(ethAddr), valAddr.Bytes())
 }
 
 // GetValidatorEthereumAddress returns the eth address for a given gravity validator.
@@ -207,28 +216,30 @@ func (k Keeper) GetValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddr
 }
 
 func (k Keeper) validatorForEthAddressExists(ctx sdk.Context, ethAddr common.Address) bool {
-	store := ctx.KVStore(k.storeKey)
-	iter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
-
-	for ; iter.Valid(); iter.Next() {
-		if common.BytesToAddress(iter.Value()) == ethAddr {
-			return true
-		}
-	}
+	return ctx.KVStore(k.storeKey).Has(types.MakeEthereumValidatorAddressKey(ethAddr))
+}
 
-	return false
+// GetEthereumValidatorAddress returns the validator that uses the given eth address
+func (k Keeper) GetEthereumValidatorAddress(ctx sdk.Context, ethAddr common.Address) sdk.ValAddress {
+	return ctx.KVStore(k.storeKey).Get(types.MakeEthereumValidatorAddressKey(ethAddr))
 }
 
 ////////////////////////
 // ETH -> ORC ADDRESS //
 ////////////////////////
 
-// setEthereumOrchestratorAddress sets the eth orch addr mapping
+// setEthereumOrchestratorAddress sets the eth orch addr mapping and its reverse
+// index, removing the reverse index of any previous orchestrator
 func (k Keeper) setEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.Address, orch sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.MakeEthereumOrchestratorAddressKey(ethAddr)
 
+	if prev := store.Get(key); prev != nil {
+		store.Delete(types.MakeOrchestratorEthereumAddressKey(prev))
+	}
+
 	store.Set(key, orch.Bytes())
+	store.Set(types.MakeOrchestratorEthereumAddressKey(orch), ethAddr.Bytes())
 }
 
 // GetEthereumOrchestratorAddress gets the orch address for a given eth address
@@ -239,17 +250,89 @@ func (k Keeper) GetEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.A
 	return store.Get(key)
 }
 
-func (k Keeper) ethAddressForOrchestratorExists(ctx sdk.Context, orch sdk.AccAddress) bool {
+// deleteEthereumOrchestratorAddress deletes the orchestrator mapping of an eth
+// address and its reverse index
+func (k Keeper) deleteEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.Address) {
 	store := ctx.KVStore(k.storeKey)
-	iter := prefix.NewStore(store, []byte{types.EthereumOrchestratorAddressKey}).Iterator(nil, nil)
+	key := types.MakeEthereumOrchestratorAddressKey(ethAddr)
 
+	if orch := store.Get(key); orch != nil {
+		store.Delete(types.MakeOrchestratorEthereumAddressKey(orch))
+	}
+	store.Delete(key)
+}
+
+func (k Keeper) ethAddressForOrchestratorExists(ctx sdk.Context, orch sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeOrchestratorEthereumAddressKey(orch))
+}
+
+//////////////////////////////
+// ROTATED ETHEREUM ADDRESS //
+//////////////////////////////
+
+// setRotatedEthereumAddress keeps the Ethereum address a validator rotated
+// away from, for as long as the Gravity contract still knows it as signer
+func (k Keeper) setRotatedEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.MakeRotatedEthereumAddressKey(valAddr), ethAddr.Bytes())
+}
+
+// getRotatedEthereumAddress returns the Ethereum address a validator rotated
+// away from, or the zero address if no rotation is pending
+func (k Keeper) getRotatedEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress) common.Address {
+	return common.BytesToAddress(ctx.KVStore(k.storeKey).Get(types.MakeRotatedEthereumAddressKey(valAddr)))
+}
+
+// pruneRotatedEthereumAddresses deletes the rotated Ethereum addresses that
+// are no longer members of the given observed signer set
+func (k Keeper) pruneRotatedEthereumAddresses(ctx sdk.Context, signers types.EthereumSigners) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.RotatedEthereumAddressKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var pruned [][]byte
 	for ; iter.Valid(); iter.Next() {
-		if sdk.AccAddress(iter.Value()).String() == orch.String() {
-			return true
+		if !signers.Contains(common.BytesToAddress(iter.Value())) {
+			pruned = append(pruned, iter.Key())
 		}
 	}
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// iterateRotatedEthereumAddresses iterates over the pending rotations of
+// validators' Ethereum keys
+func (k Keeper) iterateRotatedEthereumAddresses(ctx sdk.Context, cb func(val sdk.ValAddress, ethAddr common.Address) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.RotatedEthereumAddressKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()), common.BytesToAddress(iter.Value())) {
+			break
+		}
+	}
+}
+
+// ethereumSignatureSigner returns the Ethereum address of a validator that
+// made a stored signature, which is the rotated address if the signature was
+// made with the validator's previous key
+func (k Keeper) ethereumSignatureSigner(ctx sdk.Context, checkpoint []byte, val sdk.ValAddress, sig []byte) common.Address {
+	if signing := k.GetSigningEthereumAddress(ctx, val); types.ValidateEthereumSignature(checkpoint, sig, signing) == nil {
+		return signing
+	}
+	return k.GetValidatorEthereumAddress(ctx, val)
+}
 
-	return false
+// GetSigningEthereumAddress returns the Ethereum address a validator signs
+// outgoing txs with. After a rotation of its Ethereum key this stays the
+// previous address until a signer set without it is observed, since the
+// Gravity contract only accepts signatures from the observed signer set.
+func (k Keeper) GetSigningEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress) common.Address {
+	if rotated := k.getRotatedEthereumAddress(ctx, valAddr); rotated != (common.Address{}) {
+		return rotated
+	}
+	return k.GetValidatorEthereumAddress(ctx, valAddr)
 }
 
 // CreateSignerSetTx gets the current signer set from the staking keeper, increments the nonce,
@@ -275,6 +358,27 @@ func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 		"height", newSignerSetTx.Height,
 		"signers", len(newSignerSetTx.Signers),
 	)
+
+	minCoverage := k.GetParams(ctx).MinSignerSetCoverage
+	if coverage, _, _, missing := k.signerSetCoverage(ctx); coverage.LT(minCoverage) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSignerSetCoverageWarning,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(nonce)),
+				sdk.NewAttribute(types.AttributeKeySignerSetCoverage, coverage.String()),
+				sdk.NewAttribute(types.AttributeKeyMinSignerSetCoverage, minCoverage.String()),
+				sdk.NewAttribute(types.AttributeKeyValidatorsMissingDelegateKeys, strings.Join(missing, ",")),
+			),
+		)
+		k.Logger(ctx).Error(
+			"SignerSetTx created below minimum coverage",
+			"nonce", newSignerSetTx.Nonce,
+			"coverage", coverage.String(),
+			"minCoverage", minCoverage.String(),
+			"validatorsMissingDelegateKeys", len(missing),
+		)
+	}
 	return newSignerSetTx
 }
 
@@ -294,6 +398,10 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 	ethereumSigners := make([]*types.EthereumSigner, 0)
 	var totalPower uint64
 	for _, validator := range validators {
+		if validator.IsJailed() {
+			continue
+		}
+
 		val := validator.GetOperator()
 
 		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
@@ -309,7 +417,39 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 		ethereumSigners[i].Power = sdk.NewUint(ethereumSigners[i].Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64()
 	}
 
-	return ethereumSigners
+	signerSet := types.EthereumSigners(ethereumSigners)
+	signerSet.CapPowers(k.GetParams(ctx).MaxSignerPowerShare)
+
+	return signerSet
+}
+
+// signerSetCoverage computes the fraction of bonded, unjailed power that
+// CurrentSignerSet represents on Ethereum. Validators without an Ethereum
+// address are left out of the signer set, and are returned so that operators
+// can be told which validators still need to set their delegate keys.
+func (k Keeper) signerSetCoverage(ctx sdk.Context) (coverage sdk.Dec, representedPower, totalPower uint64, missing []string) {
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		if validator.IsJailed() {
+			continue
+		}
+
+		val := validator.GetOperator()
+		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
+		totalPower += p
+
+		if ethAddr := k.GetValidatorEthereumAddress(ctx, val); ethAddr.Hex() != "0x0000000000000000000000000000000000000000" {
+			representedPower += p
+		} else {
+			missing = append(missing, val.String())
+		}
+	}
+
+	if totalPower == 0 {
+		return sdk.ZeroDec(), 0, 0, missing
+	}
+
+	coverage = sdk.NewDecFromInt(sdk.NewIntFromUint64(representedPower)).QuoInt(sdk.NewIntFromUint64(totalPower))
+	return coverage, representedPower, totalPower, missing
 }
 
 // GetSignerSetTxs returns all the signer set txs from the store
@@ -369,14 +509,8 @@ func (k Keeper) getGravityID(ctx sdk.Context) string {
 
 // getDelegateKeys iterates both the EthAddress and Orchestrator address indexes to produce
 // a vector of MsgDelegateKeys entries containing all the delgate keys for state
-// export / import. This may seem at first glance to be excessively complicated, why not combine
-// the EthAddress and Orchestrator address indexes and simply iterate one thing? The answer is that
-// even though we set the Eth and Orchestrator address in the same place we use them differently we
-// always go from Orchestrator address to Validator address and from validator address to Ethereum address
-// we want to keep looking up the validator address for various reasons, so a direct Orchestrator to Ethereum
-// address mapping will mean having to keep two of the same data around just to provide lookups.
-//
-// For the time being this will serve
+// export / import. The reverse indexes only exist for uniqueness checks and
+// are rebuilt from these entries on import.
 func (k Keeper) getDelegateKeys(ctx sdk.Context) (out []*types.MsgDelegateKeys) {
 	store := ctx.KVStore(k.storeKey)
 	iter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
@@ -533,6 +667,74 @@ func (k Keeper) setLastObservedSignerSetTx(ctx sdk.Context, signerSet types.Sign
 	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&signerSet))
 }
 
+// signedPowerOnEthereum sums the power of the last observed Ethereum signer
+// set members that have signed the given outgoing tx, and returns the members
+// that have not, in signer set order. Signers are found by recovering the
+// Ethereum address from each stored signature rather than by the current
+// delegate keys, since those may have changed since the set was observed. It
+// returns nil if no signer set has been observed yet.
+func (k Keeper) signedPowerOnEthereum(ctx sdk.Context, otx types.OutgoingTx) (signerSet *types.SignerSetTx, signedPower uint64, unsigned types.EthereumSigners) {
+	signerSet = k.GetLastObservedSignerSetTx(ctx)
+	if signerSet == nil {
+		return nil, 0, nil
+	}
+
+	powers := make(map[common.Address]uint64, len(signerSet.Signers))
+	for _, signer := range signerSet.Signers {
+		powers[common.HexToAddress(signer.EthereumAddress)] += signer.Power
+	}
+
+	checkpoint := otx.GetCheckpoint([]byte(k.getGravityID(ctx)))
+	k.iterateEthereumSignatures(ctx, otx.GetStoreIndex(), func(_ sdk.ValAddress, sig []byte) bool {
+		addr, err := types.EthereumAddressFromSignature(checkpoint, sig)
+		if err != nil {
+			return false
+		}
+		// count each signer once even if several validators submitted its signature
+		signedPower += powers[addr]
+		delete(powers, addr)
+		return false
+	})
+
+	// the signers left in powers have not signed
+	for _, signer := range signerSet.Signers {
+		if _, ok := powers[common.HexToAddress(signer.EthereumAddress)]; ok {
+			unsigned = append(unsigned, signer)
+		}
+	}
+
+	return signerSet, signedPower, unsigned
+}
+
+// relaySignatures returns the signatures of an outgoing tx in the order of the
+// given signers, as the Gravity contract expects them, along with the power
+// that has signed. Signers that have not signed get an empty signature, which
+// the contract skips.
+func (k Keeper) relaySignatures(ctx sdk.Context, otx types.OutgoingTx, signers types.EthereumSigners) (sigs []types.ABIEncodedValSignature, signedPower uint64) {
+	signatures := make(map[common.Address]types.ABIEncodedValSignature)
+	checkpoint := otx.GetCheckpoint([]byte(k.getGravityID(ctx)))
+	k.iterateEthereumSignatures(ctx, otx.GetStoreIndex(), func(_ sdk.ValAddress, sig []byte) bool {
+		addr, err := types.EthereumAddressFromSignature(checkpoint, sig)
+		if err != nil {
+			return false
+		}
+		if signature, err := types.NewABIEncodedValSignature(sig); err == nil {
+			signatures[addr] = signature
+		}
+		return false
+	})
+
+	sigs = make([]types.ABIEncodedValSignature, len(signers))
+	for i, signer := range signers {
+		if signature, ok := signatures[common.HexToAddress(signer.EthereumAddress)]; ok {
+			sigs[i] = signature
+			signedPower += signer.Power
+		}
+	}
+
+	return sigs, signedPower
+}
+
 // CreateContractCallTx xxx
 func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64, invalidationScope tmbytes.HexBytes,
 	address common.Address, payload []byte, tokens []types.ERC20Token, fees []types.ERC20Token) *types.ContractCallTx {
@@ -715,6 +917,7 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 		Height:  0,
 		Signers: nil,
 	})
+	k.pruneRotatedEthereumAddresses(ctx, nil)
 
 	// Set the batch Nonce to zero
 	store.Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(0))
@@ -49,6 +49,35 @@ func TestCurrentValsetNormalization(t *testing.T) {
 	}
 }
 
+func TestCurrentSignerSetPowerCap(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	operators := make([]MockStakingValidatorData, 4)
+	for i, power := range []int64{70, 10, 10, 10} {
+		cAddr := bytes.Repeat([]byte{byte(i)}, 20)
+		operators[i] = MockStakingValidatorData{Operator: cAddr, Power: power}
+		gk.setValidatorEthereumAddress(ctx, cAddr, common.BytesToAddress(bytes.Repeat([]byte{byte(i + 1)}, 20)))
+	}
+	gk.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
+
+	uncapped := gk.CreateSignerSetTx(ctx)
+	require.EqualValues(t, 3006477106, uncapped.Signers[0].Power)
+
+	params := gk.GetParams(ctx)
+	params.MaxSignerPowerShare = sdk.NewDecWithPrec(4, 1)
+	gk.setParams(ctx, params)
+
+	// the cap changes the signer set power enough to warrant a new signer set
+	current := gk.CurrentSignerSet(ctx)
+	require.Greater(t, current.PowerDiff(uncapped.Signers), 0.05)
+
+	capped := gk.CreateSignerSetTx(ctx)
+	require.Equal(t, []uint64{1717986917, 858993458, 858993458, 858993458}, capped.Signers.GetPowers())
+	require.Zero(t, gk.CurrentSignerSet(ctx).PowerDiff(capped.Signers))
+}
+
 func TestAttestationIterator(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrator_Migrate2to3(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	store := ctx.KVStore(gk.storeKey)
+
+	// v2 state has no reverse indexes
+	for _, key := range []byte{types.EthereumValidatorAddressKey, types.OrchestratorEthereumAddressKey} {
+		prefixStore := prefix.NewStore(store, []byte{key})
+		iter := prefixStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, k := range keys {
+			prefixStore.Delete(k)
+		}
+	}
+
+	// and may hold the keys of a validator that re-submitted MsgDelegateKeys
+	staleOrch, _ := sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+	staleEth := gethcommon.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	store.Set(types.MakeOrchestratorValidatorAddressKey(staleOrch), ValAddrs[0])
+	store.Set(types.MakeEthereumOrchestratorAddressKey(staleEth), staleOrch)
+
+	require.False(t, gk.validatorForEthAddressExists(ctx, EthAddrs[0]))
+	require.False(t, gk.ethAddressForOrchestratorExists(ctx, AccAddrs[0]))
+
+	// v2 params lack the params added in v3, reading the param set panics
+	// until they are migrated
+	paramStore := prefix.NewStore(ctx.KVStore(input.ParamsStoreKey), append([]byte(types.DefaultParamspace), '/'))
+	for _, key := range [][]byte{
+		types.ParamsStoreKeySignerSetTxPowerChangeThreshold,
+		types.ParamsStoreKeyMinSignerSetCoverage,
+		types.ParamsStoreKeyMaxSignerPowerShare,
+		types.ParamsStoreKeyExecutedTxHistoryWindow,
+	} {
+		paramStore.Delete(key)
+	}
+	require.Panics(t, func() { gk.GetParams(ctx) })
+
+	// a v3 param that is already set keeps its value
+	maxSignerSetTxBlockInterval := uint64(5000)
+	gk.paramSpace.Set(ctx, types.ParamsStoreKeyMaxSignerSetTxBlockInterval, maxSignerSetTxBlockInterval)
+
+	require.NoError(t, NewMigrator(gk).Migrate2to3(ctx))
+
+	for i := range ValAddrs {
+		require.True(t, gk.validatorForEthAddressExists(ctx, EthAddrs[i]))
+		require.Equal(t, ValAddrs[i], gk.GetEthereumValidatorAddress(ctx, EthAddrs[i]))
+		require.True(t, gk.ethAddressForOrchestratorExists(ctx, AccAddrs[i]))
+		require.Equal(t, ValAddrs[i], gk.GetOrchestratorValidatorAddress(ctx, AccAddrs[i]))
+	}
+
+	require.False(t, gk.validatorForEthAddressExists(ctx, staleEth))
+	require.False(t, gk.ethAddressForOrchestratorExists(ctx, staleOrch))
+	require.Empty(t, gk.GetOrchestratorValidatorAddress(ctx, staleOrch))
+	require.Empty(t, gk.GetEthereumOrchestratorAddress(ctx, staleEth))
+
+	params := gk.GetParams(ctx)
+	defaults := types.DefaultParams()
+	require.Equal(t, defaults.SignerSetTxPowerChangeThreshold, params.SignerSetTxPowerChangeThreshold)
+	require.Equal(t, maxSignerSetTxBlockInterval, params.MaxSignerSetTxBlockInterval)
+	require.Equal(t, defaults.MinSignerSetCoverage, params.MinSignerSetCoverage)
+	require.Equal(t, defaults.MaxSignerPowerShare, params.MaxSignerPowerShare)
+	require.Equal(t, defaults.ExecutedTxHistoryWindow, params.ExecutedTxHistoryWindow)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
-	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -47,6 +46,12 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
 	}
 
+	// setting the keys again would leave the previous keys acting on behalf of
+	// the validator, keys can only be replaced by rotating them
+	if k.GetValidatorEthereumAddress(ctx, valAddr) != (common.Address{}) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "delegate keys already set for validator %s, use MsgRotateDelegateKeys to replace them", valAddr)
+	}
+
 	// check if the Ethereum address is currently not used
 	if k.validatorForEthAddressExists(ctx, ethAddr) {
 		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "ethereum address %s in use", ethAddr)
@@ -57,10 +62,124 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
 	}
 
+	if err = k.verifyDelegateKeysSignature(ctx, valAddr, ethAddr, msg.EthSignature); err != nil {
+		return nil, err
+	}
+
+	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
+	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgDelegateKeysResponse{}, nil
+
+}
+
+// RotateDelegateKeys handles MsgRotateDelegateKeys
+func (k msgServer) RotateDelegateKeys(c context.Context, msg *types.MsgRotateDelegateKeys) (*types.MsgRotateDelegateKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	orchAddr, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ethAddr := common.HexToAddress(msg.EthereumAddress)
+
+	validator := k.Keeper.StakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
+	}
+
+	oldEthAddr := k.GetValidatorEthereumAddress(ctx, valAddr)
+	if oldEthAddr == (common.Address{}) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "no delegate keys set for validator %s", valAddr)
+	}
+	oldOrchAddr := k.GetEthereumOrchestratorAddress(ctx, oldEthAddr)
+
+	ethAddrChanged := ethAddr != oldEthAddr
+	orchAddrChanged := !orchAddr.Equals(oldOrchAddr)
+	if !ethAddrChanged && !orchAddrChanged {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "delegate keys for validator %s are unchanged", valAddr)
+	}
+
+	if ethAddrChanged && k.validatorForEthAddressExists(ctx, ethAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "ethereum address %s in use", ethAddr)
+	}
+
+	// the Gravity contract only learns about a new Ethereum key through a
+	// signer set signed with the previous one, so a key may only be rotated
+	// again once a signer set without the key it replaced has been observed
+	if ethAddrChanged && k.getRotatedEthereumAddress(ctx, valAddr) != (common.Address{}) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "previous ethereum key rotation of validator %s is pending until a signer set without the replaced key is observed", valAddr)
+	}
+
+	if orchAddrChanged && k.ethAddressForOrchestratorExists(ctx, orchAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
+	}
+
+	if err = k.verifyDelegateKeysSignature(ctx, valAddr, ethAddr, msg.EthSignature); err != nil {
+		return nil, err
+	}
+
+	// remove the stale entries before writing the new ones, so that the
+	// previous keys can no longer act on behalf of the validator
+	if orchAddrChanged {
+		k.deleteOrchestratorValidatorAddress(ctx, oldOrchAddr)
+	}
+	if ethAddrChanged {
+		k.deleteEthereumOrchestratorAddress(ctx, oldEthAddr)
+
+		// keep signing with the previous key while the contract still knows it
+		if signerSet := k.GetLastObservedSignerSetTx(ctx); signerSet != nil && types.EthereumSigners(signerSet.Signers).Contains(oldEthAddr) {
+			k.setRotatedEthereumAddress(ctx, valAddr, oldEthAddr)
+		}
+	}
+
+	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
+	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	// the Ethereum signer set has to be updated for the new key to be able to
+	// sign outgoing txs that the contract accepts
+	if ethAddrChanged && validator.IsBonded() && !validator.IsJailed() {
+		k.CreateSignerSetTx(ctx)
+	}
+
+	return &types.MsgRotateDelegateKeysResponse{}, nil
+}
+
+// verifyDelegateKeysSignature checks that the signature was made by the given
+// Ethereum address over the DelegateKeysSignMsg of the validator
+func (k msgServer) verifyDelegateKeysSignature(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address, signature []byte) error {
 	valAccAddr := sdk.AccAddress(valAddr)
 	valAccSeq, err := k.accountKeeper.GetSequence(ctx, valAccAddr)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
+		return sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
 	}
 
 	var nonce uint64
@@ -77,30 +196,15 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 
 	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
 
-	if err = types.ValidateEthereumSignature(hash, msg.EthSignature, ethAddr); err != nil {
-		return nil, sdkerrors.Wrapf(
+	if err := types.ValidateEthereumSignature(hash, signature, ethAddr); err != nil {
+		return sdkerrors.Wrapf(
 			types.ErrDelegateKeys,
 			"failed to validate delegate keys signature for Ethereum address %X; %s ;%d",
 			ethAddr, err, nonce,
 		)
 	}
 
-	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
-	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
-	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
-			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
-			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
-			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
-		),
-	)
-
-	return &types.MsgDelegateKeysResponse{}, nil
-
+	return nil
 }
 
 // SubmitEthereumTxConfirmation handles MsgSubmitEthereumTxConfirmation
@@ -117,56 +221,125 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 		return nil, err
 	}
 
+	if err = k.submitEthereumTxConfirmation(ctx, val, confirmation, msg.Confirmation.TypeUrl, msg.Type()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitEthereumTxConfirmationResponse{}, nil
+}
+
+// SubmitEthereumTxConfirmations handles MsgSubmitEthereumTxConfirmations
+func (k msgServer) SubmitEthereumTxConfirmations(c context.Context, msg *types.MsgSubmitEthereumTxConfirmations) (*types.MsgSubmitEthereumTxConfirmationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*types.EthereumTxConfirmationResult, len(msg.Confirmations))
+	for i, confirmationAny := range msg.Confirmations {
+		// the tx pays for a single signature verification in the ante handler,
+		// so each confirmation is charged for its own
+		ctx.GasMeter().ConsumeGas(types.EthereumTxConfirmationGasCost, "ethereum tx confirmation")
+
+		// each confirmation is written on its own, so a rejected one leaves no
+		// partial state behind
+		cacheCtx, write := ctx.CacheContext()
+		err := func() error {
+			confirmation, err := types.UnpackConfirmation(confirmationAny)
+			if err != nil {
+				return err
+			}
+			if err = confirmation.Validate(); err != nil {
+				return err
+			}
+			return k.submitEthereumTxConfirmation(cacheCtx, val, confirmation, confirmationAny.TypeUrl, msg.Type())
+		}()
+		if err != nil {
+			results[i] = &types.EthereumTxConfirmationResult{Error: err.Error()}
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		results[i] = &types.EthereumTxConfirmationResult{Success: true}
+	}
+
+	return &types.MsgSubmitEthereumTxConfirmationsResponse{Results: results}, nil
+}
+
+// submitEthereumTxConfirmation verifies and stores a validator's signature
+// over an outgoing tx
+func (k msgServer) submitEthereumTxConfirmation(ctx sdk.Context, val sdk.ValAddress, confirmation types.EthereumTxConfirmation, typeURL, msgType string) error {
 	otx := k.GetOutgoingTx(ctx, confirmation.GetStoreIndex())
 	if otx == nil {
 		k.Logger(ctx).Error(
 			"no outgoing tx",
 			"store index", fmt.Sprintf("%x", confirmation.GetStoreIndex()),
 		)
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find outgoing tx")
+		return sdkerrors.Wrap(types.ErrInvalid, "couldn't find outgoing tx")
 	}
 
 	gravityID := k.getGravityID(ctx)
 	checkpoint := otx.GetCheckpoint([]byte(gravityID))
 
-	ethAddress := k.GetValidatorEthereumAddress(ctx, val)
-	if ethAddress != confirmation.GetSigner() {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "eth address does not match signer eth address")
+	// while an Ethereum key rotation is pending the validator may sign with
+	// either key, since only the previous one is accepted by the contract
+	ethAddress := confirmation.GetSigner()
+	if ethAddress != k.GetValidatorEthereumAddress(ctx, val) && ethAddress != k.GetSigningEthereumAddress(ctx, val) {
+		return sdkerrors.Wrap(types.ErrInvalid, "eth address does not match signer eth address")
 	}
 
-	if err = types.ValidateEthereumSignature(checkpoint, confirmation.GetSignature(), ethAddress); err != nil {
+	if err := types.ValidateEthereumSignature(checkpoint, confirmation.GetSignature(), ethAddress); err != nil {
 		k.Logger(ctx).Error("error validating signature",
 			"eth addr", ethAddress.String(),
 			"gravityID", gravityID,
 			"checkpoint", hex.EncodeToString(checkpoint),
-			"type url", msg.Confirmation.TypeUrl,
+			"type url", typeURL,
 			"signature", hex.EncodeToString(confirmation.GetSignature()),
 			"error", err)
-		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf(
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf(
 			"signature verification failed ethAddress %s gravityID %s checkpoint %s typeURL %s signature %s err %s",
 			ethAddress.Hex(),
 			gravityID,
 			hex.EncodeToString(checkpoint),
-			msg.Confirmation.TypeUrl,
+			typeURL,
 			hex.EncodeToString(confirmation.GetSignature()),
 			err,
 		))
 	}
-	// TODO: should validators be able to overwrite their signatures?
-	if k.getEthereumSignature(ctx, confirmation.GetStoreIndex(), val) != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature duplicate")
+	// a validator may only replace a signature that the Gravity contract would
+	// not accept, i.e. one not made by its key in the last observed signer set,
+	// e.g. after a key rotation or a faulty signer. The signature stays keyed by
+	// validator, so slashing sees no change either way.
+	previous := k.getEthereumSignature(ctx, confirmation.GetStoreIndex(), val)
+	if previous != nil && types.ValidateEthereumSignature(checkpoint, previous, k.GetSigningEthereumAddress(ctx, val)) == nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "signature duplicate")
 	}
 
 	key := k.SetEthereumSignature(ctx, confirmation, val)
 
+	if previous != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEthereumSignatureReplaced,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, hex.EncodeToString(confirmation.GetStoreIndex())),
+				sdk.NewAttribute(types.AttributeKeyReplacedEthereumSignature, hex.EncodeToString(previous)),
+			),
+		)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, msgType),
 			sdk.NewAttribute(types.AttributeKeyEthereumSignatureKey, string(key)),
 		),
 	)
-	return &types.MsgSubmitEthereumTxConfirmationResponse{}, nil
+	return nil
 }
 
 // SubmitEthereumEvent handles MsgSubmitEthereumEvent
@@ -184,10 +357,44 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 		return nil, err
 	}
 
-	// Add the claim to the store
-	_, err = k.recordEventVote(ctx, event, val)
+	if err = k.submitEthereumEvent(ctx, event, val); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitEthereumEventResponse{}, nil
+}
+
+// SubmitEthereumEvents handles MsgSubmitEthereumEvents
+func (k msgServer) SubmitEthereumEvents(c context.Context, msg *types.MsgSubmitEthereumEvents) (*types.MsgSubmitEthereumEventsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// return an error if the validator isn't in the active set
+	val, err := k.getSignerValidator(ctx, msg.Signer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "create event vote record")
+		return nil, err
+	}
+
+	// the events are recorded atomically, since the message runs in a cached
+	// context that is discarded when any of them is rejected
+	for i, eventAny := range msg.Events {
+		event, err := types.UnpackEvent(eventAny)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "event %d", i)
+		}
+
+		if err = k.submitEthereumEvent(ctx, event, val); err != nil {
+			return nil, sdkerrors.Wrapf(err, "event %d", i)
+		}
+	}
+
+	return &types.MsgSubmitEthereumEventsResponse{}, nil
+}
+
+// submitEthereumEvent records a validator's vote for an event
+func (k msgServer) submitEthereumEvent(ctx sdk.Context, event types.EthereumEvent, val sdk.ValAddress) error {
+	// Add the claim to the store
+	if _, err := k.recordEventVote(ctx, event, val); err != nil {
+		return sdkerrors.Wrap(err, "create event vote record")
 	}
 
 	// Emit the handle message event
@@ -200,7 +407,7 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 		),
 	)
 
-	return &types.MsgSubmitEthereumEventResponse{}, nil
+	return nil
 }
 
 // SendToEthereum handles MsgSendToEthereum
@@ -215,26 +422,18 @@ func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereu
 	types.NormalizeCoinDenom(&msg.Amount)
 	types.NormalizeCoinDenom(&msg.BridgeFee)
 
-	txID, err := k.createSendToEthereum(ctx, sender, msg.EthereumRecipient, msg.Amount, msg.BridgeFee)
+	txID, err := k.Keeper.SendToEthereum(ctx, sender, msg.EthereumRecipient, msg.Amount, msg.BridgeFee)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvents([]sdk.Event{
-		sdk.NewEvent(
-			types.EventTypeBridgeWithdrawalReceived,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txID))),
-			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(txID)),
-		),
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
 			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
 		),
-	})
+	)
 
 	return &types.MsgSendToEthereumResponse{Id: txID}, nil
 }
@@ -276,19 +475,12 @@ func (k msgServer) CancelSendToEthereum(c context.Context, msg *types.MsgCancelS
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvents([]sdk.Event{
-		sdk.NewEvent(
-			types.EventTypeBridgeWithdrawCanceled,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-		),
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
-			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
-		),
-	})
+	k.emitWithdrawCanceled(ctx, msg.Id)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
+	))
 
 	return &types.MsgCancelSendToEthereumResponse{}, nil
 }
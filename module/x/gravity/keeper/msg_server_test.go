@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -75,6 +76,176 @@ func TestMsgServer_SubmitEthereumSignature(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_ReplaceEthereumSignature(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr    = sdk.ValAddress(orcAddr)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr, orcAddr)
+
+	oldKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	newKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	gk.setValidatorEthereumAddress(ctx, valAddr, crypto.PubkeyToAddress(oldKey.PublicKey))
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	checkpoint := signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx)))
+	gk.setLastObservedSignerSetTx(ctx, *signerSetTx)
+
+	msgServer := NewMsgServerImpl(gk)
+	submit := func(key *ecdsa.PrivateKey) (sdk.Context, error) {
+		signature, err := types.NewEthereumSignature(checkpoint, key)
+		require.NoError(t, err)
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       orcAddr.String(),
+		})
+		return ctx, err
+	}
+	replaced := func(ctx sdk.Context) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeEthereumSignatureReplaced {
+				return true
+			}
+		}
+		return false
+	}
+
+	eventCtx, err := submit(oldKey)
+	require.NoError(t, err)
+	require.False(t, replaced(eventCtx))
+	oldSignature := gk.getEthereumSignature(ctx, signerSetTx.GetStoreIndex(), valAddr)
+
+	// a signature that is still valid can't be replaced
+	_, err = submit(oldKey)
+	require.Error(t, err)
+
+	// after a key rotation the signature stays while the contract still
+	// accepts the previous key
+	gk.setValidatorEthereumAddress(ctx, valAddr, crypto.PubkeyToAddress(newKey.PublicKey))
+	gk.setRotatedEthereumAddress(ctx, valAddr, crypto.PubkeyToAddress(oldKey.PublicKey))
+	_, err = submit(newKey)
+	require.Error(t, err)
+	require.Equal(t, oldSignature, gk.getEthereumSignature(ctx, signerSetTx.GetStoreIndex(), valAddr))
+
+	// and is replaced once a signer set with the new key is observed
+	gk.pruneRotatedEthereumAddresses(ctx, types.EthereumSigners{{Power: 1, EthereumAddress: crypto.PubkeyToAddress(newKey.PublicKey).Hex()}})
+	eventCtx, err = submit(newKey)
+	require.NoError(t, err)
+	require.True(t, replaced(eventCtx))
+
+	signatures := gk.GetEthereumSignatures(ctx, signerSetTx.GetStoreIndex())
+	require.Len(t, signatures, 1)
+	require.NotEqual(t, oldSignature, signatures[valAddr.String()])
+	require.NoError(t, types.ValidateEthereumSignature(checkpoint, signatures[valAddr.String()], crypto.PubkeyToAddress(newKey.PublicKey)))
+
+	// as is an invalid one
+	gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSetTx.Nonce,
+		Signature:      []byte("invalid"),
+	}, valAddr)
+	eventCtx, err = submit(newKey)
+	require.NoError(t, err)
+	require.True(t, replaced(eventCtx))
+}
+
+func TestMsgServer_SubmitEthereumTxConfirmations(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr    = sdk.ValAddress(orcAddr)
+		ethAddr    = crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr, orcAddr)
+	gk.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
+
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	batchTx := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       1000,
+		TokenContract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4",
+		Height:        100,
+	}
+	gk.SetOutgoingTx(ctx, batchTx)
+
+	gravityID := []byte(gk.getGravityID(ctx))
+	signerSetSig, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint(gravityID), ethPrivKey)
+	require.NoError(t, err)
+	// signed over the wrong checkpoint
+	batchSig, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint(gravityID), ethPrivKey)
+	require.NoError(t, err)
+
+	var confirmations []*cdctypes.Any
+	for _, conf := range []types.EthereumTxConfirmation{
+		&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr.Hex(),
+			Signature:      signerSetSig,
+		},
+		&types.BatchTxConfirmation{
+			TokenContract:  batchTx.TokenContract,
+			BatchNonce:     batchTx.BatchNonce,
+			EthereumSigner: ethAddr.Hex(),
+			Signature:      batchSig,
+		},
+		&types.BatchTxConfirmation{
+			TokenContract:  batchTx.TokenContract,
+			BatchNonce:     batchTx.BatchNonce + 1,
+			EthereumSigner: ethAddr.Hex(),
+			Signature:      batchSig,
+		},
+	} {
+		confirmation, err := types.PackConfirmation(conf)
+		require.NoError(t, err)
+		confirmations = append(confirmations, confirmation)
+	}
+
+	msg := &types.MsgSubmitEthereumTxConfirmations{
+		Confirmations: confirmations,
+		Signer:        orcAddr.String(),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res, err := NewMsgServerImpl(gk).SubmitEthereumTxConfirmations(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, 3*types.EthereumTxConfirmationGasCost)
+
+	require.Len(t, res.Results, 3)
+	require.True(t, res.Results[0].Success)
+	require.Empty(t, res.Results[0].Error)
+	require.False(t, res.Results[1].Success)
+	require.Contains(t, res.Results[1].Error, "signature verification failed")
+	require.False(t, res.Results[2].Success)
+	require.Contains(t, res.Results[2].Error, "couldn't find outgoing tx")
+
+	require.Len(t, gk.GetEthereumSignatures(ctx, signerSetTx.GetStoreIndex()), 1)
+	require.Empty(t, gk.GetEthereumSignatures(ctx, batchTx.GetStoreIndex()))
+}
+
 func TestMsgServer_SendToEthereum(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -380,6 +551,76 @@ func TestMsgServer_SubmitEthereumEvent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SubmitEthereumEvents(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr, _   = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr      = sdk.ValAddress(orcAddr)
+		testContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr, orcAddr)
+
+	newMsg := func(nonces ...uint64) *types.MsgSubmitEthereumEvents {
+		msg := &types.MsgSubmitEthereumEvents{Signer: orcAddr.String()}
+		for _, nonce := range nonces {
+			event, err := types.PackEvent(&types.SendToCosmosEvent{
+				EventNonce:     nonce,
+				TokenContract:  testContract.Hex(),
+				Amount:         sdk.NewInt(1000),
+				EthereumSender: testContract.Hex(),
+				CosmosReceiver: orcAddr.String(),
+				EthereumHeight: 200 + nonce,
+			})
+			require.NoError(t, err)
+			msg.Events = append(msg.Events, event)
+		}
+		return msg
+	}
+
+	require.Error(t, newMsg().ValidateBasic())
+	require.Error(t, newMsg(1, 3).ValidateBasic())
+	require.Error(t, newMsg(2, 1).ValidateBasic())
+	require.NoError(t, newMsg(1, 2, 3).ValidateBasic())
+
+	msgServer := NewMsgServerImpl(gk)
+
+	// like baseapp, each message runs in a cache context that is only written
+	// when it succeeds
+	submit := func(msg *types.MsgSubmitEthereumEvents) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := msgServer.SubmitEthereumEvents(sdk.WrapSDKContext(cacheCtx), msg)
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	// the first event doesn't follow the validator's last event nonce, so
+	// nothing is recorded
+	err := submit(newMsg(2, 3))
+	require.ErrorContains(t, err, "event 0")
+	require.ErrorContains(t, err, "non contiguous event nonce expected 1 observed 2")
+	require.Zero(t, gk.getLastEventNonceByValidator(ctx, valAddr))
+
+	err = submit(newMsg(1, 2, 3))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, gk.getLastEventNonceByValidator(ctx, valAddr))
+	require.Len(t, gk.GetEthereumEventVoteRecordMapping(ctx), 3)
+
+	// a failure part way through reverts the events before it
+	err = submit(newMsg(4, 5))
+	require.NoError(t, err)
+	err = submit(newMsg(6, 5))
+	require.ErrorContains(t, err, "event 1")
+	require.EqualValues(t, 5, gk.getLastEventNonceByValidator(ctx, valAddr))
+	require.Len(t, gk.GetEthereumEventVoteRecordMapping(ctx), 5)
+}
+
 func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -423,6 +664,26 @@ func TestMsgServer_SetDelegateKeys(t *testing.T) {
 
 	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+
+	// keys can't be set again, they have to be rotated
+	ethPrivKey2, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr2 := crypto.PubkeyToAddress(ethPrivKey2.PublicKey)
+	orcAddr2, _ := sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+	sig2, err := types.NewEthereumSignature(hash, ethPrivKey2)
+	require.NoError(t, err)
+
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), &types.MsgDelegateKeys{
+		ValidatorAddress:    valAddr1.String(),
+		OrchestratorAddress: orcAddr2.String(),
+		EthereumAddress:     ethAddr2.String(),
+		EthSignature:        sig2,
+	})
+	require.ErrorIs(t, err, types.ErrDelegateKeys)
+	require.Equal(t, ethAddr1, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+	require.Empty(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr2))
+	require.False(t, gk.validatorForEthAddressExists(ctx, ethAddr2))
 }
 
 func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
@@ -515,3 +776,88 @@ func TestEthVerify(t *testing.T) {
 	gorcSig := "0xbda7037e448ca07ac91f5f386b72df37b6bbacf102b2c8f5acb58b5e053d68d96875ce9e442433bea55ac083230f492670ca2c07a8303c332dca06b1c0758c661b"
 	require.Equal(t, hexutil.Encode(sig), gorcSig)
 }
+
+func TestMsgServer_RotateDelegateKeys(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(gk)
+
+	// Set the sequence to 1 because the antehandler will do this in the full
+	// chain.
+	acc := input.AccountKeeper.GetAccount(ctx, AccAddrs[0])
+	require.NoError(t, acc.SetSequence(1))
+	input.AccountKeeper.SetAccount(ctx, acc)
+
+	signMsgBz := input.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: ValAddrs[0].String(),
+		Nonce:            0,
+	})
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	sig, err := types.NewEthereumSignature(hash, ethPrivKey)
+	require.NoError(t, err)
+
+	orcAddr, _ := sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+
+	rotate := func(orch sdk.AccAddress, eth common.Address, sig []byte) error {
+		msg := types.NewMsgRotateDelegateKeys(ValAddrs[0], orch, eth.Hex(), sig)
+		_, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	require.Error(t, rotate(AccAddrs[0], EthAddrs[0], sig), "unchanged keys")
+	require.Error(t, rotate(AccAddrs[0], EthAddrs[1], sig), "ethereum address in use")
+	require.Error(t, rotate(AccAddrs[1], EthAddrs[0], sig), "orchestrator address in use")
+	require.Error(t, rotate(orcAddr, EthAddrs[0], sig), "signature not made by the ethereum key")
+
+	// the contract knows the current keys
+	observed := types.SignerSetTx{Nonce: 1, Signers: gk.CurrentSignerSet(ctx)}
+	gk.setLastObservedSignerSetTx(ctx, observed)
+
+	// rotating the ethereum key replaces it in the signer set
+	nonce := gk.GetLatestSignerSetTxNonce(ctx)
+	require.NoError(t, rotate(AccAddrs[0], ethAddr, sig))
+	require.Equal(t, nonce+1, gk.GetLatestSignerSetTxNonce(ctx))
+	var found bool
+	for _, signer := range gk.GetLatestSignerSetTx(ctx).Signers {
+		found = found || signer.EthereumAddress == ethAddr.Hex()
+		require.NotEqual(t, EthAddrs[0].Hex(), signer.EthereumAddress)
+	}
+	require.True(t, found)
+
+	require.Equal(t, ethAddr, gk.GetValidatorEthereumAddress(ctx, ValAddrs[0]))
+	require.Equal(t, AccAddrs[0], gk.GetEthereumOrchestratorAddress(ctx, ethAddr))
+	require.Empty(t, gk.GetEthereumOrchestratorAddress(ctx, EthAddrs[0]))
+	require.Equal(t, ValAddrs[0], gk.GetOrchestratorValidatorAddress(ctx, AccAddrs[0]))
+
+	// the previous key keeps signing until the new signer set is observed
+	require.Equal(t, EthAddrs[0], gk.GetSigningEthereumAddress(ctx, ValAddrs[0]))
+
+	ethPrivKey2, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr2 := crypto.PubkeyToAddress(ethPrivKey2.PublicKey)
+	sig2, err := types.NewEthereumSignature(hash, ethPrivKey2)
+	require.NoError(t, err)
+	require.ErrorIs(t, rotate(AccAddrs[0], ethAddr2, sig2), types.ErrDelegateKeys, "previous rotation pending")
+
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		SignerSetTxNonce: nonce + 1,
+		Members:          gk.GetLatestSignerSetTx(ctx).Signers,
+	}))
+	require.Equal(t, ethAddr, gk.GetSigningEthereumAddress(ctx, ValAddrs[0]))
+
+	// rotating only the orchestrator key does not need a new signer set
+	require.NoError(t, rotate(orcAddr, ethAddr, sig))
+	require.Equal(t, nonce+1, gk.GetLatestSignerSetTxNonce(ctx))
+
+	require.Equal(t, orcAddr, gk.GetEthereumOrchestratorAddress(ctx, ethAddr))
+	require.Equal(t, ValAddrs[0], gk.GetOrchestratorValidatorAddress(ctx, orcAddr))
+	require.Empty(t, gk.GetOrchestratorValidatorAddress(ctx, AccAddrs[0]))
+
+	val, err := gk.getSignerValidator(ctx, orcAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, ValAddrs[0], val)
+}
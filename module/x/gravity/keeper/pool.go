@@ -3,22 +3,35 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
-// createSendToEthereum
+// createSendToEthereum adds a transfer from an account to the outgoing pool
+func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	return k.createSendToEthereumFrom(ctx, sender, "", counterpartReceiver, amount, fee)
+}
+
+// createSendToEthereumFromModule adds a transfer from a module account to the outgoing pool
+func (k Keeper) createSendToEthereumFromModule(ctx sdk.Context, senderModule string, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	return k.createSendToEthereumFrom(ctx, authtypes.NewModuleAddress(senderModule), senderModule, counterpartReceiver, amount, fee)
+}
+
+// createSendToEthereumFrom
 // - checks a counterpart denominator exists for the given voucher type
+// - scales cosmos originated amounts to the decimals of their ERC20
 // - burns the voucher for transfer amount and fees
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool via a second index
-func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+func (k Keeper) createSendToEthereumFrom(ctx sdk.Context, sender sdk.AccAddress, senderModule string, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
 
@@ -30,7 +43,30 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 		return 0, err
 	}
 
-	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
+	erc20Amount, erc20Fee := amount.Amount, fee.Amount
+	if isCosmosOriginated {
+		decimalShift := k.GetDenomDecimalShift(ctx, totalAmount.Denom)
+		if amount, erc20Amount, err = toERC20Amount(amount, decimalShift); err != nil {
+			return 0, err
+		}
+		if fee, erc20Fee, err = toERC20Amount(fee, decimalShift); err != nil {
+			return 0, err
+		}
+		if erc20Amount.IsZero() {
+			return 0, sdkerrors.Wrapf(types.ErrInvalid, "amount is less than one unit of ERC20 %s", tokenContract.Hex())
+		}
+
+		// dust stays with account senders, but a module sender may already
+		// have accounted for the full amount, e.g. the community pool
+		if senderModule != "" && !amount.Add(fee).IsEqual(totalAmount) {
+			return 0, sdkerrors.Wrapf(types.ErrInvalid, "%s is not a whole amount of ERC20 %s", totalAmount, tokenContract.Hex())
+		}
+
+		totalAmount = amount.Add(fee)
+		totalInVouchers = sdk.Coins{totalAmount}
+	}
+
+	if senderModule != "" {
 		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, totalInVouchers); err != nil {
 			return 0, err
 		}
@@ -59,20 +95,115 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 		Id:                nextID,
 		Sender:            sender.String(),
 		EthereumRecipient: counterpartReceiver,
-		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
-		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
+		Erc20Token:        types.NewSDKIntERC20Token(erc20Amount, tokenContract),
+		Erc20Fee:          types.NewSDKIntERC20Token(erc20Fee, tokenContract),
 	})
 
 	return nextID, nil
 }
 
-// cancelSendToEthereum
-// - checks that the provided tx actually exists
-// - deletes the unbatched tx from the pool
-// - issues the tokens back to the sender
+// SendToEthereum adds a transfer of amount to the ethereum recipient, paying
+// fee to the relayer, to the outgoing pool and emits the withdrawal received
+// event, for messages and for modules sending on behalf of an account
+func (k Keeper) SendToEthereum(ctx sdk.Context, sender sdk.AccAddress, ethereumRecipient string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	txID, err := k.createSendToEthereum(ctx, sender, ethereumRecipient, amount, fee)
+	if err != nil {
+		return 0, err
+	}
+
+	k.emitWithdrawalReceived(ctx, txID)
+
+	return txID, nil
+}
+
+// SendToEthereumFromModule is SendToEthereum for transfers paid from the
+// account of senderModule. Only the module can cancel the transfer, with
+// CancelSendToEthereumFromModule, while it is unbatched.
+func (k Keeper) SendToEthereumFromModule(ctx sdk.Context, senderModule string, ethereumRecipient string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	txID, err := k.createSendToEthereumFromModule(ctx, senderModule, ethereumRecipient, amount, fee)
+	if err != nil {
+		return 0, err
+	}
+
+	k.emitWithdrawalReceived(ctx, txID)
+
+	return txID, nil
+}
+
+// CancelSendToEthereumFromModule removes an unbatched transfer created by
+// SendToEthereumFromModule from the outgoing pool and refunds senderModule
+func (k Keeper) CancelSendToEthereumFromModule(ctx sdk.Context, id uint64, senderModule string) error {
+	if err := k.cancelSendToEthereumFrom(ctx, id, authtypes.NewModuleAddress(senderModule), senderModule); err != nil {
+		return err
+	}
+
+	k.emitWithdrawCanceled(ctx, id)
+
+	return nil
+}
+
+func (k Keeper) emitWithdrawalReceived(ctx sdk.Context, txID uint64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeWithdrawalReceived,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txID))),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(txID)),
+	))
+}
+
+func (k Keeper) emitWithdrawCanceled(ctx sdk.Context, txID uint64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeWithdrawCanceled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+	))
+}
+
+// toERC20Amount scales a cosmos originated coin to the decimals of its ERC20,
+// returning the part of the coin the ERC20 amount represents along with it
+func toERC20Amount(coin sdk.Coin, decimalShift int32) (sdk.Coin, sdk.Int, error) {
+	erc20Amount, dust, err := types.ToERC20Amount(coin.Amount, decimalShift)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+
+	return coin.Sub(sdk.NewCoin(coin.Denom, dust)), erc20Amount, nil
+}
+
+// checkWholeERC20Amount returns an error if a cosmos originated coin can't be
+// sent to Ethereum without leaving dust under its decimal shift
+func (k Keeper) checkWholeERC20Amount(ctx sdk.Context, coin sdk.Coin) error {
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, coin.Denom)
+	if err != nil || !isCosmosOriginated {
+		return err
+	}
+
+	_, dust, err := types.ToERC20Amount(coin.Amount, k.GetDenomDecimalShift(ctx, coin.Denom))
+	if err != nil {
+		return err
+	}
+	if !dust.IsZero() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "%s is not a whole amount of ERC20 %s", coin, tokenContract.Hex())
+	}
+
+	return nil
+}
+
+// cancelSendToEthereum cancels a transfer from an account
 func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error {
 	sender, _ := sdk.AccAddressFromBech32(s)
+	return k.cancelSendToEthereumFrom(ctx, id, sender, "")
+}
 
+// cancelSendToEthereumFrom
+// - checks that the provided tx actually exists
+// - deletes the unbatched tx from the pool
+// - issues the tokens back to the sender, or its module account
+func (k Keeper) cancelSendToEthereumFrom(ctx sdk.Context, id uint64, sender sdk.AccAddress, senderModule string) error {
 	var send *types.SendToEthereum
 	for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
 		if ste.Id == id {
@@ -90,6 +221,13 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 
 	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(send.Erc20Token.Contract))
 	amountToRefund := send.Erc20Token.Amount.Add(send.Erc20Fee.Amount)
+	if isCosmosOriginated {
+		// the escrowed amount scales back exactly, its dust was never taken
+		var err error
+		if amountToRefund, _, err = types.FromERC20Amount(amountToRefund, k.GetDenomDecimalShift(ctx, denom)); err != nil {
+			return err
+		}
+	}
 	coinsToRefund := sdk.NewCoins(sdk.NewCoin(denom, amountToRefund))
 
 	// If it is not cosmos-originated the coins are minted
@@ -99,8 +237,14 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		}
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
-		return sdkerrors.Wrap(err, "sending coins from module account")
+	if senderModule != "" {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, senderModule, coinsToRefund); err != nil {
+			return sdkerrors.Wrap(err, "sending coins from module account")
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
+			return sdkerrors.Wrap(err, "sending coins from module account")
+		}
 	}
 
 	k.deleteUnbatchedSendToEthereum(ctx, send.Id, send.Erc20Fee)
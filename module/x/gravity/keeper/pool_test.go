@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
@@ -51,3 +54,103 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.EqualValues(t, exp[3], got[3])
 	require.Len(t, got, 4)
 }
+
+func TestDecimalShiftedTransfers(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		mySender, _    = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver     = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		cosmosReceiver = sdk.AccAddress([]byte("cosmos-receiver-addr"))
+		fewerDecimals  = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		moreDecimals   = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	)
+
+	// the ERC20 of uatom has 3 decimals less than uatom, the ERC20 of ufoo 3 more
+	k.setCosmosOriginatedDenomToERC20(ctx, "uatom", fewerDecimals)
+	k.setDenomDecimalShift(ctx, "uatom", -3)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ufoo", moreDecimals)
+	k.setDenomDecimalShift(ctx, "ufoo", 3)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10000), sdk.NewInt64Coin("ufoo", 10000))))
+
+	// amounts are truncated to whole ERC20 units, the dust stays with the sender
+	id, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin("uatom", 1999), sdk.NewInt64Coin("uatom", 500))
+	require.NoError(t, err)
+	sends := k.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, sends, 1)
+	require.Equal(t, types.NewERC20Token(1, fewerDecimals), sends[0].Erc20Token)
+	require.Equal(t, types.NewERC20Token(0, fewerDecimals), sends[0].Erc20Fee)
+	require.Equal(t, sdk.NewInt(9000), input.BankKeeper.GetBalance(ctx, mySender, "uatom").Amount)
+
+	// sends of less than one ERC20 unit are rejected
+	_, err = k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin("uatom", 999), sdk.NewInt64Coin("uatom", 999))
+	require.Error(t, err)
+
+	// cancelling refunds exactly what was escrowed
+	require.NoError(t, k.cancelSendToEthereum(ctx, id, mySender.String()))
+	require.Equal(t, sdk.NewInt(10000), input.BankKeeper.GetBalance(ctx, mySender, "uatom").Amount)
+
+	// amounts scale up exactly when the ERC20 has more decimals
+	_, err = k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin("ufoo", 5), sdk.NewInt64Coin("ufoo", 1))
+	require.NoError(t, err)
+	sends = k.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, sends, 1)
+	require.Equal(t, types.NewERC20Token(5000, moreDecimals), sends[0].Erc20Token)
+	require.Equal(t, types.NewERC20Token(1000, moreDecimals), sends[0].Erc20Fee)
+
+	// deposits are truncated to whole denom units, the dust stays on Ethereum
+	require.NoError(t, k.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  moreDecimals.Hex(),
+		Amount:         sdk.NewInt(2500),
+		EthereumSender: myReceiver.Hex(),
+		CosmosReceiver: cosmosReceiver.String(),
+	}))
+	require.Equal(t, sdk.NewInt(2), input.BankKeeper.GetBalance(ctx, cosmosReceiver, "ufoo").Amount)
+
+	// deposits of less than one denom unit are handled without receiving anything
+	require.NoError(t, k.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     2,
+		TokenContract:  moreDecimals.Hex(),
+		Amount:         sdk.NewInt(999),
+		EthereumSender: myReceiver.Hex(),
+		CosmosReceiver: cosmosReceiver.String(),
+	}))
+	require.Equal(t, sdk.NewInt(2), input.BankKeeper.GetBalance(ctx, cosmosReceiver, "ufoo").Amount)
+}
+
+func TestSendToEthereumFromModule(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = types.GravityDenom(tokenContract)
+		moduleAddress = authtypes.NewModuleAddress(govtypes.ModuleName)
+	)
+
+	require.NoError(t, fundModAccount(ctx, input.BankKeeper, govtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	id, err := k.SendToEthereumFromModule(ctx, govtypes.ModuleName, myReceiver.Hex(), sdk.NewInt64Coin(denom, 500), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeBridgeWithdrawalReceived, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+
+	sends := k.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, sends, 1)
+	require.Equal(t, moduleAddress.String(), sends[0].Sender)
+	require.Equal(t, types.NewERC20Token(500, tokenContract), sends[0].Erc20Token)
+	require.Equal(t, int64(490), input.BankKeeper.GetBalance(ctx, moduleAddress, denom).Amount.Int64())
+
+	// only the sending module gets the refund
+	require.Error(t, k.CancelSendToEthereumFromModule(ctx, id, distrtypes.ModuleName))
+	require.NoError(t, k.CancelSendToEthereumFromModule(ctx, id, govtypes.ModuleName))
+	require.Empty(t, k.getUnbatchedSendToEthereums(ctx))
+	require.Equal(t, int64(1000), input.BankKeeper.GetBalance(ctx, moduleAddress, denom).Amount.Int64())
+}
@@ -1,18 +1,29 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *types.CommunityPoolEthereumSpendProposal) error {
 	feePool := k.DistributionKeeper.GetFeePool(ctx)
 
+	// the community pool is debited the full amounts, so none of it may be
+	// left behind as dust when scaled to the ERC20's decimals
+	for _, coin := range []sdk.Coin{p.Amount, p.BridgeFee} {
+		if err := k.checkWholeERC20Amount(ctx, coin); err != nil {
+			return err
+		}
+	}
+
 	// NOTE the community pool isn't a module account, however its coins
 	// are held in the distribution module account. Thus the community pool
-	// must be reduced separately from the createSendToEthereum calls
+	// must be reduced separately from the createSendToEthereumFromModule calls
 	totalToSpend := p.Amount.Add(p.BridgeFee)
 	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(totalToSpend))
 	if negative {
@@ -20,9 +31,8 @@ func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *typ
 	}
 
 	feePool.CommunityPool = newPool
-	sender := authtypes.NewModuleAddress(distributiontypes.ModuleName)
 
-	txID, err := k.createSendToEthereum(ctx, sender, p.Recipient, p.Amount, p.BridgeFee)
+	txID, err := k.createSendToEthereumFromModule(ctx, distributiontypes.ModuleName, p.Recipient, p.Amount, p.BridgeFee)
 	if err != nil {
 		return err
 	}
@@ -32,3 +42,171 @@ func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *typ
 
 	return nil
 }
+
+func (k Keeper) HandleCommunityPoolEthereumMultiSpendProposal(ctx sdk.Context, p *types.CommunityPoolEthereumMultiSpendProposal) error {
+	feePool := k.DistributionKeeper.GetFeePool(ctx)
+
+	for i, spend := range p.Spends {
+		for _, coin := range []sdk.Coin{spend.Amount, spend.BridgeFee} {
+			if err := k.checkWholeERC20Amount(ctx, coin); err != nil {
+				return sdkerrors.Wrapf(err, "spend %d", i)
+			}
+		}
+	}
+
+	// the spends are checked against the community pool together, the
+	// proposal fails as a whole if any one of them can't be created
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(p.Total()...))
+	if negative {
+		return distributiontypes.ErrBadDistribution
+	}
+
+	feePool.CommunityPool = newPool
+
+	for i, spend := range p.Spends {
+		txID, err := k.createSendToEthereumFromModule(ctx, distributiontypes.ModuleName, spend.Recipient, spend.Amount, spend.BridgeFee)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "spend %d", i)
+		}
+
+		k.Logger(ctx).Info("transfer from the community pool created as unbatched send to Ethereum", "tx ID", txID, "amount", spend.Amount.String(), "recipient", spend.Recipient)
+	}
+
+	k.DistributionKeeper.SetFeePool(ctx, feePool)
+
+	return nil
+}
+
+func (k Keeper) HandleCommunityPoolEthereumContractCallProposal(ctx sdk.Context, p *types.CommunityPoolEthereumContractCallProposal) error {
+	feePool := k.DistributionKeeper.GetFeePool(ctx)
+
+	// the tokens and fees are held by the gravity module until the call is
+	// executed on Ethereum or refunded to the community pool on cancellation
+	totalToSpend := p.Tokens.Add(p.Fees...)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(totalToSpend...))
+	if negative {
+		return distributiontypes.ErrBadDistribution
+	}
+
+	tokens, err := k.lockForEthereum(ctx, distributiontypes.ModuleName, p.Tokens)
+	if err != nil {
+		return err
+	}
+	fees, err := k.lockForEthereum(ctx, distributiontypes.ModuleName, p.Fees)
+	if err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = newPool
+	k.DistributionKeeper.SetFeePool(ctx, feePool)
+
+	nonce := k.incrementLastCommunityPoolContractCallNonce(ctx)
+	k.CreateContractCallTx(ctx, nonce, types.CommunityPoolContractCallScope, common.HexToAddress(p.Address), p.Payload, tokens, fees)
+	k.Logger(ctx).Info("contract call from the community pool created", "nonce", nonce, "tokens", p.Tokens.String(), "address", p.Address)
+
+	return nil
+}
+
+func (k Keeper) HandleCommunityPoolContractCallRefundProposal(ctx sdk.Context, p *types.CommunityPoolContractCallRefundProposal) error {
+	cctx := k.getUnrefundedContractCallTx(ctx, p.InvalidationNonce)
+	if cctx == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "no unrefunded community pool contract call with nonce %d", p.InvalidationNonce)
+	}
+
+	// a failed refund fails the proposal, leaving the call held for another one
+	if err := k.refundCommunityPoolContractCall(ctx, cctx); err != nil {
+		return err
+	}
+
+	k.deleteUnrefundedContractCallTx(ctx, p.InvalidationNonce)
+	k.emitContractCallTxCanceled(ctx, cctx)
+
+	return nil
+}
+
+func (k Keeper) HandleSignerSetTxCreationProposal(ctx sdk.Context, p *types.SignerSetTxCreationProposal) error {
+	// a signer set tx without signers could never be submitted to Ethereum
+	if len(k.CurrentSignerSet(ctx)) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalid, "no validators with registered ethereum keys to create signer set tx from")
+	}
+
+	signerSetTx := k.CreateSignerSetTx(ctx)
+	k.Logger(ctx).Info("signer set tx created by governance proposal", "nonce", signerSetTx.Nonce, "height", signerSetTx.Height)
+
+	return nil
+}
+
+func (k Keeper) HandleRegisterCosmosDenomProposal(ctx sdk.Context, p *types.RegisterCosmosDenomProposal) error {
+	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, p.Denom); exists {
+		return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s already exists for denom %s", existingERC20.Hex(), p.Denom)
+	}
+
+	k.setCosmosDenomRegistration(ctx, p.Registration())
+	k.Logger(ctx).Info("cosmos denom registered for ERC20 deployment by governance proposal", "denom", p.Denom)
+
+	return nil
+}
+
+func (k Keeper) HandleUpdateCosmosDenomERC20Proposal(ctx sdk.Context, p *types.UpdateCosmosDenomERC20Proposal) error {
+	previousERC20, exists := k.getCosmosOriginatedERC20(ctx, p.Denom)
+	if !exists {
+		return sdkerrors.Wrapf(types.ErrInvalid, "no ERC20 token exists for denom %s", p.Denom)
+	}
+
+	// transfers of the previous ERC20 would be settled against the wrong token
+	if k.hasPendingTransfers(ctx, previousERC20) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s of denom %s has pending transfers", previousERC20.Hex(), p.Denom)
+	}
+
+	var erc20 common.Address
+	if p.Erc20 != "" {
+		erc20 = common.HexToAddress(p.Erc20)
+		if denom, exists := k.getCosmosOriginatedDenom(ctx, erc20); exists {
+			return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s already exists for denom %s", erc20.Hex(), denom)
+		}
+		if retired, exists := k.getRetiredCosmosOriginatedERC20(ctx, erc20); exists && retired.Denom != p.Denom {
+			return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s was retired for denom %s", erc20.Hex(), retired.Denom)
+		}
+		// the ERC20 was bridged as an Ethereum originated token, its vouchers
+		// would no longer be redeemable
+		if supply := k.bankKeeper.GetSupply(ctx, types.GravityDenom(erc20)); !supply.IsZero() {
+			return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s has %s vouchers", erc20.Hex(), supply)
+		}
+	}
+
+	// deposits of the previous ERC20 that are observed later still release the
+	// denom, at the decimal shift the ERC20 was minted with
+	k.setRetiredCosmosOriginatedERC20(ctx, &types.ERC20ToDenom{
+		Erc20:        previousERC20.Hex(),
+		Denom:        p.Denom,
+		DecimalShift: k.GetDenomDecimalShift(ctx, p.Denom),
+	})
+	k.deleteCosmosOriginatedDenomToERC20(ctx, p.Denom, previousERC20)
+	k.setDenomDecimalShift(ctx, p.Denom, p.DecimalShift)
+
+	if p.Erc20 == "" {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCosmosDenomERC20Removed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, p.Denom),
+			sdk.NewAttribute(types.AttributeKeyPreviousERC20, previousERC20.Hex()),
+		))
+		k.Logger(ctx).Info("cosmos denom ERC20 mapping removed by governance proposal", "denom", p.Denom, "previous erc20", previousERC20.Hex())
+
+		return nil
+	}
+
+	k.deleteRetiredCosmosOriginatedERC20(ctx, erc20)
+	k.setCosmosOriginatedDenomToERC20(ctx, p.Denom, erc20)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCosmosDenomERC20Updated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyCosmosDenom, p.Denom),
+		sdk.NewAttribute(types.AttributeKeyPreviousERC20, previousERC20.Hex()),
+		sdk.NewAttribute(types.AttributeKeyERC20, erc20.Hex()),
+		sdk.NewAttribute(types.AttributeKeyDecimalShift, fmt.Sprint(p.DecimalShift)),
+	))
+	k.Logger(ctx).Info("cosmos denom ERC20 mapping updated by governance proposal", "denom", p.Denom, "previous erc20", previousERC20.Hex(), "erc20", erc20.Hex(), "decimal shift", p.DecimalShift)
+
+	return nil
+}
@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestHandleUpdateCosmosDenomERC20Proposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		denom        = "uatom"
+		oldERC20     = common.HexToAddress("0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e")
+		newERC20     = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		otherERC20   = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		bridgedERC20 = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		sender, _    = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	)
+	update := func(ctx sdk.Context, denom string, erc20 string, decimalShift int32) error {
+		return k.HandleUpdateCosmosDenomERC20Proposal(ctx, types.NewUpdateCosmosDenomERC20Proposal("title", "description", denom, erc20, decimalShift))
+	}
+
+	// nothing to update before the denom is mapped
+	require.Error(t, update(ctx, denom, newERC20.Hex(), 0))
+
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, oldERC20)
+	k.setDenomDecimalShift(ctx, denom, 2)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ufoo", otherERC20)
+
+	// pending transfers of the old ERC20 block the update
+	ste := types.NewSendToEthereumTx(1, oldERC20, sender, common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"), 100, 1)
+	k.setUnbatchedSendToEthereum(ctx, ste)
+	require.Error(t, update(ctx, denom, newERC20.Hex(), 0))
+	k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
+
+	batch := &types.BatchTx{BatchNonce: 1, TokenContract: oldERC20.Hex(), Transactions: []*types.SendToEthereum{ste}}
+	k.SetOutgoingTx(ctx, batch)
+	require.Error(t, update(ctx, denom, newERC20.Hex(), 0))
+	k.DeleteOutgoingTx(ctx, batch.GetStoreIndex())
+
+	call := k.CreateContractCallTx(ctx, 1, []byte("scope"), otherERC20, []byte("payload"), nil, []types.ERC20Token{types.NewERC20Token(1, oldERC20)})
+	require.Error(t, update(ctx, denom, newERC20.Hex(), 0))
+	k.DeleteOutgoingTx(ctx, call.GetStoreIndex())
+
+	// the new ERC20 can't already be mapped to another denom
+	require.Error(t, update(ctx, denom, otherERC20.Hex(), 0))
+
+	// nor have been bridged as an Ethereum originated token
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(types.NewERC20Token(1, bridgedERC20).GravityCoin())))
+	require.Error(t, update(ctx, denom, bridgedERC20.Hex(), 0))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, update(ctx, denom, newERC20.Hex(), 6))
+	erc20, exists := k.getCosmosOriginatedERC20(ctx, denom)
+	require.True(t, exists)
+	require.Equal(t, newERC20, erc20)
+	_, exists = k.getCosmosOriginatedDenom(ctx, oldERC20)
+	require.False(t, exists)
+	gotDenom, exists := k.getCosmosOriginatedDenom(ctx, newERC20)
+	require.True(t, exists)
+	require.Equal(t, denom, gotDenom)
+	require.EqualValues(t, 6, k.GetDenomDecimalShift(ctx, denom))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeCosmosDenomERC20Updated, ctx.EventManager().Events()[0].Type)
+
+	// deposits of the old ERC20 observed later still release the denom at the
+	// old decimal shift, instead of minting vouchers of the old ERC20
+	require.NoError(t, fundModAccount(ctx, input.BankKeeper, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	require.NoError(t, k.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  oldERC20.Hex(),
+		Amount:         sdk.NewInt(500),
+		EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		CosmosReceiver: sender.String(),
+		EthereumHeight: 100,
+	}))
+	require.Equal(t, sdk.NewInt64Coin(denom, 5), input.BankKeeper.GetBalance(ctx, sender, denom))
+	require.True(t, input.BankKeeper.GetSupply(ctx, types.GravityDenom(oldERC20)).IsZero())
+
+	// the old ERC20 can't be mapped to another denom
+	require.Error(t, update(ctx, "ufoo", oldERC20.Hex(), 0))
+
+	// an empty ERC20 removes the mapping
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, update(ctx, denom, "", 0))
+	_, exists = k.getCosmosOriginatedERC20(ctx, denom)
+	require.False(t, exists)
+	_, exists = k.getCosmosOriginatedDenom(ctx, newERC20)
+	require.False(t, exists)
+	require.EqualValues(t, 0, k.GetDenomDecimalShift(ctx, denom))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeCosmosDenomERC20Removed, ctx.EventManager().Events()[0].Type)
+
+	isCosmosOriginated, gotDenom, decimalShift := k.depositDenom(ctx, newERC20)
+	require.True(t, isCosmosOriginated)
+	require.Equal(t, denom, gotDenom)
+	require.EqualValues(t, 6, decimalShift)
+}
+
+func TestHandleCommunityPoolEthereumSpendProposalDecimalShift(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		funder, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		recipient = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		atomERC20 = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	// the ERC20 of uatom has 3 decimals less than uatom
+	k.setCosmosOriginatedDenomToERC20(ctx, "uatom", atomERC20)
+	k.setDenomDecimalShift(ctx, "uatom", -3)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin("uatom", 10000))
+	input.AccountKeeper.NewAccountWithAddress(ctx, funder)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, funder, pool))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, pool, funder))
+
+	// amounts that would leave dust in the distribution module are rejected
+	proposal := types.NewCommunityPoolEthereumSpendProposal("title", "description", recipient.Hex(), sdk.NewInt64Coin("uatom", 1500), sdk.NewInt64Coin("uatom", 1000))
+	require.Error(t, k.HandleCommunityPoolEthereumSpendProposal(ctx, proposal))
+	proposal = types.NewCommunityPoolEthereumSpendProposal("title", "description", recipient.Hex(), sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uatom", 500))
+	require.Error(t, k.HandleCommunityPoolEthereumSpendProposal(ctx, proposal))
+	require.Empty(t, k.getUnbatchedSendToEthereums(ctx))
+	require.Equal(t, int64(10000), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("uatom").TruncateInt64())
+
+	proposal = types.NewCommunityPoolEthereumSpendProposal("title", "description", recipient.Hex(), sdk.NewInt64Coin("uatom", 2000), sdk.NewInt64Coin("uatom", 1000))
+	require.NoError(t, k.HandleCommunityPoolEthereumSpendProposal(ctx, proposal))
+
+	sends := k.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, sends, 1)
+	require.Equal(t, types.NewERC20Token(2, atomERC20), sends[0].Erc20Token)
+	require.Equal(t, types.NewERC20Token(1, atomERC20), sends[0].Erc20Fee)
+
+	// the community pool and the distribution module balance stay in step
+	distributionAddress := authtypes.NewModuleAddress(distributiontypes.ModuleName)
+	require.Equal(t, int64(7000), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("uatom").TruncateInt64())
+	require.Equal(t, int64(7000), input.BankKeeper.GetBalance(ctx, distributionAddress, "uatom").Amount.Int64())
+
+	// module senders can't leave dust behind either
+	_, err := k.createSendToEthereumFromModule(ctx, distributiontypes.ModuleName, recipient.Hex(), sdk.NewInt64Coin("uatom", 1500), sdk.NewInt64Coin("uatom", 0))
+	require.Error(t, err)
+}
+
+func TestHandleCommunityPoolEthereumContractCallProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		funder, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		vault         = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		atomERC20     = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		ethereumERC20 = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		voucherDenom  = types.GravityDenom(ethereumERC20)
+		payload       = []byte("deposit")
+	)
+
+	// the ERC20 of uatom has 3 decimals less than uatom
+	k.setCosmosOriginatedDenomToERC20(ctx, "uatom", atomERC20)
+	k.setDenomDecimalShift(ctx, "uatom", -3)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100000), sdk.NewInt64Coin(voucherDenom, 1000))
+	input.AccountKeeper.NewAccountWithAddress(ctx, funder)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, funder, pool))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, pool, funder))
+
+	// the community pool can't be overspent
+	proposal := types.NewCommunityPoolEthereumContractCallProposal("title", "description", vault.Hex(), payload,
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 200000)), sdk.NewCoins())
+	require.Error(t, k.HandleCommunityPoolEthereumContractCallProposal(ctx, proposal))
+
+	// amounts must be whole ERC20 units
+	proposal = types.NewCommunityPoolEthereumContractCallProposal("title", "description", vault.Hex(), payload,
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)), sdk.NewCoins())
+	require.Error(t, k.HandleCommunityPoolEthereumContractCallProposal(ctx, proposal))
+
+	proposal = types.NewCommunityPoolEthereumContractCallProposal("title", "description", vault.Hex(), payload,
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 20000), sdk.NewInt64Coin(voucherDenom, 400)), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+	require.NoError(t, k.HandleCommunityPoolEthereumContractCallProposal(ctx, proposal))
+
+	call, ok := k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(types.CommunityPoolContractCallScope, 1)).(*types.ContractCallTx)
+	require.True(t, ok)
+	require.Equal(t, vault.Hex(), call.Address)
+	require.Equal(t, payload, call.Payload)
+	require.Equal(t, []types.ERC20Token{types.NewERC20Token(400, ethereumERC20), types.NewERC20Token(20, atomERC20)}, call.Tokens)
+	require.Equal(t, []types.ERC20Token{types.NewERC20Token(1, atomERC20)}, call.Fees)
+
+	communityPool := input.DistKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, int64(79000), communityPool.AmountOf("uatom").TruncateInt64())
+	require.Equal(t, int64(600), communityPool.AmountOf(voucherDenom).TruncateInt64())
+	require.Equal(t, int64(21000), input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), "uatom").Amount.Int64())
+	require.Equal(t, int64(600), input.BankKeeper.GetSupply(ctx, voucherDenom).Amount.Int64())
+
+	// executing a later call invalidates the earlier one, which is refunded
+	proposal = types.NewCommunityPoolEthereumContractCallProposal("title", "description", vault.Hex(), payload,
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 5000)), sdk.NewCoins())
+	require.NoError(t, k.HandleCommunityPoolEthereumContractCallProposal(ctx, proposal))
+	require.NotNil(t, k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(types.CommunityPoolContractCallScope, 2)))
+
+	k.contractCallExecuted(ctx, types.CommunityPoolContractCallScope, 2, 0)
+	require.Nil(t, k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(types.CommunityPoolContractCallScope, 1)))
+
+	communityPool = input.DistKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, int64(95000), communityPool.AmountOf("uatom").TruncateInt64())
+	require.Equal(t, int64(1000), communityPool.AmountOf(voucherDenom).TruncateInt64())
+	require.Equal(t, int64(5000), input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), "uatom").Amount.Int64())
+	require.Equal(t, int64(1000), input.BankKeeper.GetSupply(ctx, voucherDenom).Amount.Int64())
+}
+
+func TestHandleCommunityPoolEthereumMultiSpendProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		funder, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		recipient1    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		recipient2    = common.HexToAddress("0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e")
+		atomERC20     = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		ethereumERC20 = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		voucherDenom  = types.GravityDenom(ethereumERC20)
+	)
+
+	k.setCosmosOriginatedDenomToERC20(ctx, "uatom", atomERC20)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin(voucherDenom, 1000))
+	input.AccountKeeper.NewAccountWithAddress(ctx, funder)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, funder, pool))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, pool, funder))
+
+	// the spends are checked against the community pool together
+	proposal := types.NewCommunityPoolEthereumMultiSpendProposal("title", "description", []types.CommunityPoolEthereumSpend{
+		types.NewCommunityPoolEthereumSpend(recipient1.Hex(), sdk.NewInt64Coin("uatom", 600), sdk.NewInt64Coin("uatom", 10)),
+		types.NewCommunityPoolEthereumSpend(recipient2.Hex(), sdk.NewInt64Coin("uatom", 400), sdk.NewInt64Coin("uatom", 10)),
+	})
+	require.NoError(t, proposal.ValidateBasic())
+	require.Error(t, k.HandleCommunityPoolEthereumMultiSpendProposal(ctx, proposal))
+	require.Empty(t, k.getUnbatchedSendToEthereums(ctx))
+
+	proposal = types.NewCommunityPoolEthereumMultiSpendProposal("title", "description", []types.CommunityPoolEthereumSpend{
+		types.NewCommunityPoolEthereumSpend(recipient1.Hex(), sdk.NewInt64Coin("uatom", 600), sdk.NewInt64Coin("uatom", 10)),
+		types.NewCommunityPoolEthereumSpend(recipient2.Hex(), sdk.NewInt64Coin("uatom", 300), sdk.NewInt64Coin("uatom", 10)),
+		types.NewCommunityPoolEthereumSpend(recipient2.Hex(), sdk.NewInt64Coin(voucherDenom, 500), sdk.NewInt64Coin(voucherDenom, 0)),
+	})
+	require.NoError(t, k.HandleCommunityPoolEthereumMultiSpendProposal(ctx, proposal))
+
+	sends := k.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, sends, 3)
+	recipients := map[string]sdk.Int{}
+	for _, send := range sends {
+		require.Equal(t, authtypes.NewModuleAddress(distributiontypes.ModuleName).String(), send.Sender)
+		recipients[send.EthereumRecipient+send.Erc20Token.Contract] = send.Erc20Token.Amount
+	}
+	require.Equal(t, int64(600), recipients[recipient1.Hex()+atomERC20.Hex()].Int64())
+	require.Equal(t, int64(300), recipients[recipient2.Hex()+atomERC20.Hex()].Int64())
+	require.Equal(t, int64(500), recipients[recipient2.Hex()+ethereumERC20.Hex()].Int64())
+
+	communityPool := input.DistKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, int64(80), communityPool.AmountOf("uatom").TruncateInt64())
+	require.Equal(t, int64(500), communityPool.AmountOf(voucherDenom).TruncateInt64())
+	require.Equal(t, int64(500), input.BankKeeper.GetSupply(ctx, voucherDenom).Amount.Int64())
+
+	// an empty list of spends is rejected
+	require.Error(t, types.NewCommunityPoolEthereumMultiSpendProposal("title", "description", nil).ValidateBasic())
+
+	// with the ERC20 of uatom having 1 decimal less, any spend leaving dust
+	// fails the proposal before the community pool is debited
+	k.setDenomDecimalShift(ctx, "uatom", -1)
+	proposal = types.NewCommunityPoolEthereumMultiSpendProposal("title", "description", []types.CommunityPoolEthereumSpend{
+		types.NewCommunityPoolEthereumSpend(recipient1.Hex(), sdk.NewInt64Coin("uatom", 20), sdk.NewInt64Coin("uatom", 10)),
+		types.NewCommunityPoolEthereumSpend(recipient2.Hex(), sdk.NewInt64Coin("uatom", 15), sdk.NewInt64Coin("uatom", 10)),
+	})
+	require.Error(t, k.HandleCommunityPoolEthereumMultiSpendProposal(ctx, proposal))
+	require.Len(t, k.getUnbatchedSendToEthereums(ctx), 3)
+	require.Equal(t, int64(80), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("uatom").TruncateInt64())
+}
+
+func TestHandleCommunityPoolContractCallRefundProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		funder, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		vault     = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		atomERC20 = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		escrow    = sdk.NewCoins(sdk.NewInt64Coin("uatom", 20000))
+	)
+
+	k.setCosmosOriginatedDenomToERC20(ctx, "uatom", atomERC20)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100000))
+	input.AccountKeeper.NewAccountWithAddress(ctx, funder)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, funder, pool))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, pool, funder))
+
+	proposal := types.NewCommunityPoolEthereumContractCallProposal("title", "description", vault.Hex(), []byte("deposit"), escrow, sdk.NewCoins())
+	require.NoError(t, k.HandleCommunityPoolEthereumContractCallProposal(ctx, proposal))
+	proposal = types.NewCommunityPoolEthereumContractCallProposal("title", "description", vault.Hex(), []byte("deposit"), sdk.NewCoins(sdk.NewInt64Coin("uatom", 5000)), sdk.NewCoins())
+	require.NoError(t, k.HandleCommunityPoolEthereumContractCallProposal(ctx, proposal))
+
+	// without the escrowed coins the refund of the invalidated call fails, the
+	// call is held instead of halting the chain
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, escrow))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.contractCallExecuted(ctx, types.CommunityPoolContractCallScope, 2, 0)
+
+	require.Nil(t, k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(types.CommunityPoolContractCallScope, 1)))
+	held := k.getUnrefundedContractCallTx(ctx, 1)
+	require.NotNil(t, held)
+	require.Equal(t, []types.ERC20Token{types.NewERC20Token(20000, atomERC20)}, held.Tokens)
+
+	var refundFailed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeContractCallRefundFailed {
+			refundFailed = true
+		}
+	}
+	require.True(t, refundFailed)
+
+	communityPool := input.DistKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, int64(75000), communityPool.AmountOf("uatom").TruncateInt64())
+
+	// the refund is retried by governance, failing until the coins are back
+	refund := types.NewCommunityPoolContractCallRefundProposal("title", "description", 1)
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(t, k.HandleCommunityPoolContractCallRefundProposal(cacheCtx, refund))
+	require.NotNil(t, k.getUnrefundedContractCallTx(ctx, 1))
+
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, escrow))
+	require.NoError(t, k.HandleCommunityPoolContractCallRefundProposal(ctx, refund))
+	require.Nil(t, k.getUnrefundedContractCallTx(ctx, 1))
+
+	communityPool = input.DistKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, int64(95000), communityPool.AmountOf("uatom").TruncateInt64())
+	require.Equal(t, int64(5000), input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), "uatom").Amount.Int64())
+
+	// a call can only be refunded once
+	require.Error(t, k.HandleCommunityPoolContractCallRefundProposal(ctx, refund))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+type receivingModule struct {
+	name     string
+	receiver types.SendToCosmosReceiver
+}
+
+// RegisterReceivingModule makes deposits to the account of moduleName be sent
+// to the module account and, if receiver is not nil, passed to its
+// OnSendToCosmos callback. Deposits to unregistered module accounts are sent
+// like deposits to any other account.
+func (k *Keeper) RegisterReceivingModule(moduleName string, receiver types.SendToCosmosReceiver) *Keeper {
+	address := authtypes.NewModuleAddress(moduleName).String()
+	if _, ok := k.receivingModules[address]; ok {
+		panic("cannot register gravity receiving module " + moduleName + " twice")
+	}
+
+	k.receivingModules[address] = receivingModule{name: moduleName, receiver: receiver}
+
+	return k
+}
+
+// onSendToCosmos passes a deposit to the callback of the receiving module it
+// was sent to. The callback can't fail the deposit, its state changes are
+// discarded on failure and the coins stay in the module account.
+func (k Keeper) onSendToCosmos(ctx sdk.Context, module receivingModule, event types.SendToCosmosEvent, coins sdk.Coins) {
+	if module.receiver == nil {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := module.receiver.OnSendToCosmos(cacheCtx, event, coins)
+	if err == nil {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return
+	}
+
+	k.Logger(ctx).Error("receiving module failed to handle deposit, coins left in the module account",
+		"module", module.name, "amount", coins.String(), "event nonce", event.EventNonce, "error", err)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReceivingModuleFailed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyReceivingModule, module.name),
+		sdk.NewAttribute(types.AttributeKeyReceivingModuleAmount, coins.String()),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+		sdk.NewAttribute(types.AttributeKeyReceivingModuleError, err.Error()),
+	))
+}
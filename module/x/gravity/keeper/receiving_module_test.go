@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// mockReceiver passes received coins on to an account before returning err
+type mockReceiver struct {
+	bankKeeper types.BankKeeper
+	payee      sdk.AccAddress
+	err        error
+	events     []types.SendToCosmosEvent
+}
+
+func (m *mockReceiver) OnSendToCosmos(ctx sdk.Context, event types.SendToCosmosEvent, coins sdk.Coins) error {
+	if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, govtypes.ModuleName, m.payee, coins); err != nil {
+		return err
+	}
+	m.events = append(m.events, event)
+	return m.err
+}
+
+func TestSendToCosmosEventReceivingModule(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	moduleAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	payee := sdk.AccAddress([]byte("receiver-payee-addre"))
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		CosmosReceiver: moduleAddress.String(),
+		EthereumHeight: 10,
+	}
+	coin := sdk.NewCoin(types.GravityDenom(tokenContract), sdk.NewInt(100))
+
+	receiver := &mockReceiver{bankKeeper: input.BankKeeper, payee: payee}
+	k.RegisterReceivingModule(govtypes.ModuleName, receiver)
+	require.Panics(t, func() { k.RegisterReceivingModule(govtypes.ModuleName, nil) })
+
+	// the callback gets the deposit once the coins are in the module account
+	require.NoError(t, k.Handle(ctx, event))
+	require.Equal(t, []types.SendToCosmosEvent{*event}, receiver.events)
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, payee, coin.Denom))
+	require.True(t, input.BankKeeper.GetBalance(ctx, moduleAddress, coin.Denom).IsZero())
+
+	// a failing callback doesn't fail the deposit, the coins stay in the module account
+	receiver.err = errors.New("rejected")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Handle(ctx, event))
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, payee, coin.Denom))
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, moduleAddress, coin.Denom))
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeReceivingModuleFailed, events[len(events)-1].Type)
+}
@@ -196,6 +196,9 @@ var (
 		SlashFractionBatch:                        sdk.NewDecWithPrec(1, 2),
 		SlashFractionEthereumSignature:            sdk.NewDecWithPrec(1, 2),
 		SlashFractionConflictingEthereumSignature: sdk.NewDecWithPrec(1, 2),
+		SignerSetTxPowerChangeThreshold:           sdk.NewDecWithPrec(5, 2),
+		MinSignerSetCoverage:                      sdk.NewDecWithPrec(9, 1),
+		MaxSignerPowerShare:                       sdk.ZeroDec(),
 	}
 )
 
@@ -212,6 +215,13 @@ type TestInput struct {
 	Marshaler       codec.Codec
 	LegacyAmino     *codec.LegacyAmino
 	GravityStoreKey *sdk.KVStoreKey
+	ParamsStoreKey  *sdk.KVStoreKey
+}
+
+// SetGravityParams overwrites the gravity params, allowing tests outside of
+// the keeper package to exercise non-default parameter values
+func (input TestInput) SetGravityParams(ctx sdk.Context, params types.Params) {
+	input.GravityKeeper.setParams(ctx, params)
 }
 
 func (input TestInput) AddSendToEthTxsToPool(t *testing.T, ctx sdk.Context, tokenContract gethcommon.Address, sender sdk.AccAddress, receiver gethcommon.Address, ids ...uint64) {
@@ -382,12 +392,6 @@ func CreateTestEnv(t *testing.T) TestInput {
 		accountKeeper.SetModuleAccount(ctx, mod)
 	}
 
-	// receiver/sender module account maps for the bridge
-	receiverModuleAccounts := map[string]string{
-		authtypes.NewModuleAddress(distrtypes.ModuleName).String(): distrtypes.ModuleName,
-	}
-	senderModuleAccounts := receiverModuleAccounts
-
 	stakeAddr := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
 	moduleAcct := accountKeeper.GetAccount(ctx, stakeAddr)
 	require.NotNil(t, moduleAcct)
@@ -424,14 +428,13 @@ func CreateTestEnv(t *testing.T) TestInput {
 		gravityKey,
 		getSubspace(paramsKeeper, types.DefaultParamspace),
 		accountKeeper,
-		stakingKeeper,
+		&stakingKeeper,
 		bankKeeper,
 		slashingKeeper,
 		distKeeper,
 		sdk.DefaultPowerReduction,
-		receiverModuleAccounts,
-		senderModuleAccounts,
 	)
+	k.RegisterReceivingModule(distrtypes.ModuleName, nil)
 
 	stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
@@ -455,6 +458,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		Marshaler:       marshaler,
 		LegacyAmino:     cdc,
 		GravityStoreKey: gravityKey,
+		ParamsStoreKey:  keyParams,
 	}
 }
 
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) error {
+	ctx.Logger().Info("Gravity v2 to v3: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+
+	migrateDelegateKeyIndexes(store)
+	migrateParams(ctx, paramSpace)
+
+	ctx.Logger().Info("Gravity v2 to v3: Store migration complete")
+
+	return nil
+}
+
+// migrateDelegateKeyIndexes builds the Ethereum address -> validator and
+// orchestrator -> Ethereum address reverse indexes. Only the keys currently in
+// use by a validator are indexed; the stale orchestrator and Ethereum address
+// entries that re-submitting MsgDelegateKeys used to leave behind are deleted.
+func migrateDelegateKeyIndexes(store storetypes.KVStore) {
+	valToEth := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey})
+	ethToOrch := prefix.NewStore(store, []byte{types.EthereumOrchestratorAddressKey})
+	orchToVal := prefix.NewStore(store, []byte{types.OrchestratorValidatorAddressKey})
+
+	// validator -> orchestrator currently in use
+	currentOrchs := make(map[string][]byte)
+
+	iter := valToEth.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		val, eth := iter.Key(), common.BytesToAddress(iter.Value())
+		store.Set(types.MakeEthereumValidatorAddressKey(eth), val)
+		if orch := ethToOrch.Get(eth.Bytes()); orch != nil {
+			currentOrchs[string(val)] = orch
+		}
+	}
+	iter.Close()
+
+	var staleEths [][]byte
+	iter = ethToOrch.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		eth := common.BytesToAddress(iter.Key())
+		if !store.Has(types.MakeEthereumValidatorAddressKey(eth)) {
+			staleEths = append(staleEths, iter.Key())
+			continue
+		}
+		store.Set(types.MakeOrchestratorEthereumAddressKey(iter.Value()), eth.Bytes())
+	}
+	iter.Close()
+
+	var staleOrchs [][]byte
+	iter = orchToVal.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if !bytes.Equal(currentOrchs[string(iter.Value())], iter.Key()) {
+			staleOrchs = append(staleOrchs, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, eth := range staleEths {
+		ethToOrch.Delete(eth)
+	}
+	for _, orch := range staleOrchs {
+		orchToVal.Delete(orch)
+	}
+}
+
+// migrateParams sets the params added in v3 to their defaults, since reading
+// the param set fails while any of them is missing. Params that are already
+// set are left untouched.
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	defaults := types.DefaultParams()
+
+	// governance tunable signer set tx threshold and maximum interval
+	setMissingParam(ctx, paramSpace, types.ParamsStoreKeySignerSetTxPowerChangeThreshold, defaults.SignerSetTxPowerChangeThreshold)
+	setMissingParam(ctx, paramSpace, types.ParamsStoreKeyMaxSignerSetTxBlockInterval, defaults.MaxSignerSetTxBlockInterval)
+
+	// signer set coverage reporting
+	setMissingParam(ctx, paramSpace, types.ParamsStoreKeyMinSignerSetCoverage, defaults.MinSignerSetCoverage)
+
+	// per-signer power cap
+	setMissingParam(ctx, paramSpace, types.ParamsStoreKeyMaxSignerPowerShare, defaults.MaxSignerPowerShare)
+
+	// executed outgoing tx history
+	setMissingParam(ctx, paramSpace, types.ParamsStoreKeyExecutedTxHistoryWindow, defaults.ExecutedTxHistoryWindow)
+}
+
+func setMissingParam(ctx sdk.Context, paramSpace paramtypes.Subspace, key []byte, value interface{}) {
+	if !paramSpace.Has(ctx, key) {
+		paramSpace.Set(ctx, key, value)
+	}
+}
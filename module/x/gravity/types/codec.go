@@ -16,6 +16,7 @@ import (
 // provided LegacyAmino codec. These types are used for Amino JSON serialization
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegateKeys{}, "gravity-bridge/MsgDelegateKeys", nil)
+	cdc.RegisterConcrete(&MsgRotateDelegateKeys{}, "gravity-bridge/MsgRotateDelegateKeys", nil)
 	cdc.RegisterConcrete(&MsgSendToEthereum{}, "gravity-bridge/MsgSendToEthereum", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEthereum{}, "gravity-bridge/MsgCancelSendToEthereum", nil)
 }
@@ -45,9 +46,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCancelSendToEthereum{},
 		&MsgRequestBatchTx{},
 		&MsgSubmitEthereumEvent{},
+		&MsgSubmitEthereumEvents{},
 		&MsgSubmitEthereumTxConfirmation{},
+		&MsgSubmitEthereumTxConfirmations{},
 		&MsgDelegateKeys{},
 		&MsgEthereumHeightVote{},
+		&MsgRotateDelegateKeys{},
 	)
 
 	registry.RegisterInterface(
@@ -78,6 +82,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&CommunityPoolEthereumSpendProposal{},
+		&SignerSetTxCreationProposal{},
+		&RegisterCosmosDenomProposal{},
+		&UpdateCosmosDenomERC20Proposal{},
+		&CommunityPoolEthereumContractCallProposal{},
+		&CommunityPoolEthereumMultiSpendProposal{},
+		&CommunityPoolContractCallRefundProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -14,4 +14,6 @@ var (
 	ErrInvalidEthereumProposalAmount    = sdkerrors.Register(ModuleName, 9, "invalid community pool Ethereum spend proposal amount")
 	ErrInvalidEthereumProposalBridgeFee = sdkerrors.Register(ModuleName, 10, "invalid community pool Ethereum spend proposal bridge fee")
 	ErrEthereumProposalDenomMismatch    = sdkerrors.Register(ModuleName, 11, "community pool Ethereum spend proposal amount and bridge fee denom mismatch")
+	ErrCosmosDenomNotRegistered         = sdkerrors.Register(ModuleName, 12, "cosmos denom not registered for ERC20 deployment")
+	ErrIBCForwardingDisabled            = sdkerrors.Register(ModuleName, 13, "IBC forwarding is disabled")
 )
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -113,3 +115,48 @@ func NewSendToEthereumTx(id uint64, tokenContract common.Address, sender sdk.Acc
 // Sender:            mySender.String(),
 // EthereumRecipient: myReceiver,
 // Erc20Token:        types.NewERC20Token(101, myTokenContractAddr),
+
+/////////////////////////
+//    ERC20Metadata    //
+/////////////////////////
+
+// ValidateBasic performs stateless checks on validity
+func (m *ERC20Metadata) ValidateBasic() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return sdkerrors.Wrap(ErrInvalid, "ERC20 name cannot be blank")
+	}
+	if strings.TrimSpace(m.Symbol) == "" {
+		return sdkerrors.Wrap(ErrInvalid, "ERC20 symbol cannot be blank")
+	}
+	// ERC20 decimals are a uint8
+	if m.Decimals > 255 {
+		return sdkerrors.Wrapf(ErrInvalid, "ERC20 decimals %d out of range", m.Decimals)
+	}
+	return nil
+}
+
+// VoucherMetadata returns the bank metadata of the gravity voucher of the
+// ERC20. The display unit is named after the ERC20 symbol, or after the
+// voucher denom if the symbol isn't a valid denom.
+func (m *ERC20Metadata) VoucherMetadata(contract common.Address) banktypes.Metadata {
+	base := GravityDenom(contract)
+	md := banktypes.Metadata{
+		Description: fmt.Sprintf("Gravity voucher of the %s ERC20 token %s", m.Name, contract.Hex()),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: base, Exponent: 0}},
+		Base:        base,
+		Display:     base,
+		Name:        m.Name,
+		Symbol:      m.Symbol,
+	}
+
+	if m.Decimals > 0 {
+		display := m.Symbol
+		if sdk.ValidateDenom(display) != nil || display == base {
+			display = base + "/display"
+		}
+		md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: uint32(m.Decimals)})
+		md.Display = display
+	}
+
+	return md
+}
@@ -31,22 +31,35 @@ func (m *EthereumEventVoteRecord) UnpackInterfaces(unpacker types.AnyUnpacker) e
 //////////
 
 func (stce *SendToCosmosEvent) Hash() tmbytes.HexBytes {
-	rcv, _ := sdk.AccAddressFromBech32(stce.CosmosReceiver)
-	path := bytes.Join(
-		[][]byte{
-			sdk.Uint64ToBigEndian(stce.EventNonce),
-			common.HexToAddress(stce.TokenContract).Bytes(),
-			stce.Amount.BigInt().Bytes(),
-			common.Hex2Bytes(stce.EthereumSender),
-			rcv.Bytes(),
-			sdk.Uint64ToBigEndian(stce.EthereumHeight),
-		},
-		[]byte{},
-	)
+	localAddress, forward := SplitCosmosReceiver(stce.CosmosReceiver)
+	rcv, _ := sdk.AccAddressFromBech32(localAddress)
+	parts := [][]byte{
+		sdk.Uint64ToBigEndian(stce.EventNonce),
+		common.HexToAddress(stce.TokenContract).Bytes(),
+		stce.Amount.BigInt().Bytes(),
+		common.Hex2Bytes(stce.EthereumSender),
+		rcv.Bytes(),
+		sdk.Uint64ToBigEndian(stce.EthereumHeight),
+	}
+	// events without metadata hash the same as before it was added
+	if md := stce.Erc20Metadata; md != nil {
+		parts = append(parts, lengthPrefixed(md.Name), lengthPrefixed(md.Symbol), sdk.Uint64ToBigEndian(md.Decimals))
+	}
+	// as do events without an IBC forward
+	if forward != "" {
+		parts = append(parts, lengthPrefixed(forward))
+	}
+	path := bytes.Join(parts, []byte{})
 	hash := sha256.Sum256([]byte(path))
 	return hash[:]
 }
 
+// lengthPrefixed encodes a variable length string so adjacent strings in a
+// hashed path cannot be shifted into one another
+func lengthPrefixed(s string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(len(s))), s...)
+}
+
 func (bee *BatchExecutedEvent) Hash() tmbytes.HexBytes {
 	path := bytes.Join(
 		[][]byte{
@@ -123,9 +136,17 @@ func (stce *SendToCosmosEvent) Validate() error {
 	if !common.IsHexAddress(stce.EthereumSender) {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum sender")
 	}
-	if _, err := sdk.AccAddressFromBech32(stce.CosmosReceiver); err != nil {
+	// a malformed IBC forward is not rejected, since that would hold up every
+	// later event, the deposit is received by the local address instead
+	localAddress, _ := SplitCosmosReceiver(stce.CosmosReceiver)
+	if _, err := sdk.AccAddressFromBech32(localAddress); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, stce.CosmosReceiver)
 	}
+	if stce.Erc20Metadata != nil {
+		if err := stce.Erc20Metadata.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "ERC20 metadata")
+		}
+	}
 	return nil
 }
 
@@ -7,6 +7,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// EthereumTxConfirmationGasCost is the gas charged for each confirmation of a
+// MsgSubmitEthereumTxConfirmations, matching the cost of a secp256k1 signature
+// verification in the ante handler
+const EthereumTxConfirmationGasCost uint64 = 1000
+
+// MaxEthereumTxConfirmations bounds the number of confirmations a single
+// MsgSubmitEthereumTxConfirmations may carry, since each one is verified and
+// stored in its own cache context
+const MaxEthereumTxConfirmations = 100
+
 var (
 	_ EthereumTxConfirmation = &SignerSetTxConfirmation{}
 	_ EthereumTxConfirmation = &ContractCallTxConfirmation{}
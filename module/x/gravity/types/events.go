@@ -1,14 +1,22 @@
 package types
 
 const (
-	EventTypeObservation              = "observation"
-	EventTypeOutgoingBatch            = "outgoing_batch"
-	EventTypeMultisigUpdateRequest    = "multisig_update_request"
-	EventTypeOutgoingBatchCanceled    = "outgoing_batch_canceled"
-	EventTypeContractCallTxCanceled   = "outgoing_logic_call_canceled"
-	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
-	EventTypeBridgeDepositReceived    = "deposit_received"
-	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
+	EventTypeObservation               = "observation"
+	EventTypeOutgoingBatch             = "outgoing_batch"
+	EventTypeMultisigUpdateRequest     = "multisig_update_request"
+	EventTypeOutgoingBatchCanceled     = "outgoing_batch_canceled"
+	EventTypeContractCallTxCanceled    = "outgoing_logic_call_canceled"
+	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
+	EventTypeBridgeDepositReceived     = "deposit_received"
+	EventTypeBridgeWithdrawCanceled    = "withdraw_canceled"
+	EventTypeSignerSetCoverageWarning  = "signer_set_coverage_warning"
+	EventTypeEthereumSignatureReplaced = "ethereum_signature_replaced"
+	EventTypeCosmosDenomERC20Updated   = "cosmos_denom_erc20_updated"
+	EventTypeCosmosDenomERC20Removed   = "cosmos_denom_erc20_removed"
+	EventTypeIBCForward                = "ibc_forward"
+	EventTypeIBCForwardFailed          = "ibc_forward_failed"
+	EventTypeReceivingModuleFailed     = "receiving_module_failed"
+	EventTypeContractCallRefundFailed  = "contract_call_refund_failed"
 
 	AttributeKeyEthereumEventVoteRecordID     = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey               = "batch_confirm_key"
@@ -32,4 +40,22 @@ const (
 	AttributeKeyContractCallAddress           = "contract_call_address"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
 	AttributeMissingBridgeBatchSig            = "missing_bridge_batch_signature"
+	AttributeKeySignerSetCoverage             = "signer_set_coverage"
+	AttributeKeyMinSignerSetCoverage          = "min_signer_set_coverage"
+	AttributeKeyValidatorsMissingDelegateKeys = "validators_missing_delegate_keys"
+	AttributeKeyReplacedEthereumSignature     = "replaced_ethereum_signature"
+	AttributeKeyCosmosDenom                   = "cosmos_denom"
+	AttributeKeyERC20                         = "erc20"
+	AttributeKeyPreviousERC20                 = "previous_erc20"
+	AttributeKeyDecimalShift                  = "decimal_shift"
+	AttributeKeyIBCForwardPort                = "ibc_forward_port"
+	AttributeKeyIBCForwardChannel             = "ibc_forward_channel"
+	AttributeKeyIBCForwardReceiver            = "ibc_forward_receiver"
+	AttributeKeyIBCForwardSender              = "ibc_forward_sender"
+	AttributeKeyIBCForwardAmount              = "ibc_forward_amount"
+	AttributeKeyIBCForwardError               = "ibc_forward_error"
+	AttributeKeyReceivingModule               = "receiving_module"
+	AttributeKeyReceivingModuleAmount         = "receiving_module_amount"
+	AttributeKeyReceivingModuleError          = "receiving_module_error"
+	AttributeKeyContractCallRefundError       = "contract_call_refund_error"
 )
@@ -65,6 +65,21 @@ var (
 	//  ParamStoreUnbondSlashingSignerSetTxsWindow stores unbond slashing valset window
 	ParamStoreUnbondSlashingSignerSetTxsWindow = []byte("UnbondSlashingSignerSetTxsWindow")
 
+	// ParamsStoreKeySignerSetTxPowerChangeThreshold stores the power change that triggers a new signer set tx
+	ParamsStoreKeySignerSetTxPowerChangeThreshold = []byte("SignerSetTxPowerChangeThreshold")
+
+	// ParamsStoreKeyMaxSignerSetTxBlockInterval stores the maximum number of blocks between signer set txs
+	ParamsStoreKeyMaxSignerSetTxBlockInterval = []byte("MaxSignerSetTxBlockInterval")
+
+	// ParamsStoreKeyMinSignerSetCoverage stores the bonded power coverage below which signer set txs emit a warning
+	ParamsStoreKeyMinSignerSetCoverage = []byte("MinSignerSetCoverage")
+
+	// ParamsStoreKeyMaxSignerPowerShare stores the maximum share of signer set power a single signer may hold
+	ParamsStoreKeyMaxSignerPowerShare = []byte("MaxSignerPowerShare")
+
+	// ParamsStoreKeyExecutedTxHistoryWindow stores the number of blocks executed outgoing tx records are kept for
+	ParamsStoreKeyExecutedTxHistoryWindow = []byte("ExecutedTxHistoryWindow")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -107,6 +122,32 @@ func (s GenesisState) ValidateBasic() error {
 			}
 		}
 	}
+	for _, registration := range s.CosmosDenomRegistrations {
+		if err := registration.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "cosmos denom registrations")
+		}
+	}
+	for _, record := range s.ExecutedOutgoingTxs {
+		if err := record.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "executed outgoing txs")
+		}
+	}
+	for _, retired := range s.RetiredErc20ToDenoms {
+		if err := retired.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "retired erc20 to denoms")
+		}
+	}
+	for _, rotated := range s.RotatedEthereumAddresses {
+		if err := rotated.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "rotated ethereum addresses")
+		}
+	}
+	for _, cctx := range s.UnrefundedContractCallTxs {
+		// only community pool contract calls are refunded
+		if !bytes.Equal(cctx.InvalidationScope, CommunityPoolContractCallScope) || cctx.InvalidationNonce == 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "unrefunded contract call tx with scope %x and nonce %d", cctx.InvalidationScope, cctx.InvalidationNonce)
+		}
+	}
 	return nil
 }
 
@@ -134,6 +175,11 @@ func DefaultParams() *Params {
 		SlashFractionEthereumSignature:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		SlashFractionConflictingEthereumSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		UnbondSlashingSignerSetTxsWindow:          10000,
+		SignerSetTxPowerChangeThreshold:           sdk.NewDecWithPrec(5, 2),
+		MaxSignerSetTxBlockInterval:               0,
+		MinSignerSetCoverage:                      sdk.NewDecWithPrec(9, 1),
+		MaxSignerPowerShare:                       sdk.ZeroDec(),
+		ExecutedTxHistoryWindow:                   432000,
 	}
 }
 
@@ -181,6 +227,21 @@ func (p Params) ValidateBasic() error {
 	if err := validateSlashFractionConflictingEthereumSignature(p.SlashFractionConflictingEthereumSignature); err != nil {
 		return sdkerrors.Wrap(err, "slash fraction conflicting ethereum signature")
 	}
+	if err := validateSignerSetTxPowerChangeThreshold(p.SignerSetTxPowerChangeThreshold); err != nil {
+		return sdkerrors.Wrap(err, "signer set tx power change threshold")
+	}
+	if err := validateMaxSignerSetTxBlockInterval(p.MaxSignerSetTxBlockInterval); err != nil {
+		return sdkerrors.Wrap(err, "max signer set tx block interval")
+	}
+	if err := validateMinSignerSetCoverage(p.MinSignerSetCoverage); err != nil {
+		return sdkerrors.Wrap(err, "min signer set coverage")
+	}
+	if err := validateMaxSignerPowerShare(p.MaxSignerPowerShare); err != nil {
+		return sdkerrors.Wrap(err, "max signer power share")
+	}
+	if err := validateExecutedTxHistoryWindow(p.ExecutedTxHistoryWindow); err != nil {
+		return sdkerrors.Wrap(err, "executed tx history window")
+	}
 	if err := validateUnbondSlashingSignerSetTxsWindow(p.UnbondSlashingSignerSetTxsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond slashing signersettx window")
 	}
@@ -212,6 +273,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionEthereumSignature, &p.SlashFractionEthereumSignature, validateSlashFractionEthereumSignature),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingEthereumSignature, &p.SlashFractionConflictingEthereumSignature, validateSlashFractionConflictingEthereumSignature),
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingSignerSetTxsWindow, &p.UnbondSlashingSignerSetTxsWindow, validateUnbondSlashingSignerSetTxsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignerSetTxPowerChangeThreshold, &p.SignerSetTxPowerChangeThreshold, validateSignerSetTxPowerChangeThreshold),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxSignerSetTxBlockInterval, &p.MaxSignerSetTxBlockInterval, validateMaxSignerSetTxBlockInterval),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMinSignerSetCoverage, &p.MinSignerSetCoverage, validateMinSignerSetCoverage),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxSignerPowerShare, &p.MaxSignerPowerShare, validateMaxSignerPowerShare),
+		paramtypes.NewParamSetPair(ParamsStoreKeyExecutedTxHistoryWindow, &p.ExecutedTxHistoryWindow, validateExecutedTxHistoryWindow),
 	}
 }
 
@@ -360,6 +426,53 @@ func validateSlashFractionConflictingEthereumSignature(i interface{}) error {
 	return nil
 }
 
+func validateSignerSetTxPowerChangeThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("power change threshold must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateMaxSignerSetTxBlockInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateExecutedTxHistoryWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinSignerSetCoverage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signer set coverage must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateMaxSignerPowerShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max signer power share must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -86,6 +86,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // The slashing fractions for the various gravity related slashing conditions.
 // The first three refer to not submitting a particular message, the third for
 // submitting a different ethereum_signature for the same Ethereum event
+//
+// signer_set_tx_power_change_threshold
+//
+// The normalized power difference between the current signer set and the
+// latest signer set tx above which a new signer set tx is created.
+//
+// max_signer_set_tx_block_interval
+//
+// The maximum number of blocks between signer set txs. Once this many blocks
+// have passed since the latest signer set tx, a new one is created regardless
+// of the power difference. A value of zero disables the interval.
+//
+// min_signer_set_coverage
+//
+// The minimum fraction of bonded power that should be represented in a signer
+// set. Signer set txs created below this coverage emit a warning event.
+//
+// max_signer_power_share
+//
+// The maximum fraction of the normalized signer set power a single signer may
+// hold. The excess is redistributed proportionally among the other signers.
+// A value of zero disables the cap.
 type Params struct {
 	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
 	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
@@ -103,6 +125,13 @@ type Params struct {
 	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
 	SlashFractionConflictingEthereumSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_ethereum_signature,json=slashFractionConflictingEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_ethereum_signature"`
 	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
+	SignerSetTxPowerChangeThreshold           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=signer_set_tx_power_change_threshold,json=signerSetTxPowerChangeThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signer_set_tx_power_change_threshold"`
+	MaxSignerSetTxBlockInterval               uint64                                 `protobuf:"varint,19,opt,name=max_signer_set_tx_block_interval,json=maxSignerSetTxBlockInterval,proto3" json:"max_signer_set_tx_block_interval,omitempty"`
+	MinSignerSetCoverage                      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=min_signer_set_coverage,json=minSignerSetCoverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signer_set_coverage"`
+	MaxSignerPowerShare                       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=max_signer_power_share,json=maxSignerPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_signer_power_share"`
+	// number of blocks executed outgoing tx records are kept for, zero keeps
+	// them forever
+	ExecutedTxHistoryWindow uint64 `protobuf:"varint,22,opt,name=executed_tx_history_window,json=executedTxHistoryWindow,proto3" json:"executed_tx_history_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -215,6 +244,20 @@ func (m *Params) GetUnbondSlashingSignerSetTxsWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxSignerSetTxBlockInterval() uint64 {
+	if m != nil {
+		return m.MaxSignerSetTxBlockInterval
+	}
+	return 0
+}
+
+func (m *Params) GetExecutedTxHistoryWindow() uint64 {
+	if m != nil {
+		return m.ExecutedTxHistoryWindow
+	}
+	return 0
+}
+
 // GenesisState struct
 // TODO: this need to be audited and potentially simplified using the new
 // interfaces
@@ -227,6 +270,16 @@ type GenesisState struct {
 	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
 	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
 	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	CosmosDenomRegistrations   []*CosmosDenomRegistration `protobuf:"bytes,13,rep,name=cosmos_denom_registrations,json=cosmosDenomRegistrations,proto3" json:"cosmos_denom_registrations,omitempty"`
+	ExecutedOutgoingTxs        []*ExecutedOutgoingTx      `protobuf:"bytes,14,rep,name=executed_outgoing_txs,json=executedOutgoingTxs,proto3" json:"executed_outgoing_txs,omitempty"`
+	// ERC20s of Cosmos originated denoms replaced or removed by governance
+	RetiredErc20ToDenoms []*ERC20ToDenom `protobuf:"bytes,15,rep,name=retired_erc20_to_denoms,json=retiredErc20ToDenoms,proto3" json:"retired_erc20_to_denoms,omitempty"`
+	// Ethereum addresses validators rotated away from that are still members
+	// of the last observed signer set
+	RotatedEthereumAddresses []*RotatedEthereumAddress `protobuf:"bytes,16,rep,name=rotated_ethereum_addresses,json=rotatedEthereumAddresses,proto3" json:"rotated_ethereum_addresses,omitempty"`
+	// canceled community pool contract calls whose refund failed, held until a
+	// CommunityPoolContractCallRefundProposal retries it
+	UnrefundedContractCallTxs []*ContractCallTx `protobuf:"bytes,17,rep,name=unrefunded_contract_call_txs,json=unrefundedContractCallTxs,proto3" json:"unrefunded_contract_call_txs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -318,18 +371,109 @@ func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
 	return nil
 }
 
+func (m *GenesisState) GetCosmosDenomRegistrations() []*CosmosDenomRegistration {
+	if m != nil {
+		return m.CosmosDenomRegistrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedOutgoingTxs() []*ExecutedOutgoingTx {
+	if m != nil {
+		return m.ExecutedOutgoingTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetiredErc20ToDenoms() []*ERC20ToDenom {
+	if m != nil {
+		return m.RetiredErc20ToDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRotatedEthereumAddresses() []*RotatedEthereumAddress {
+	if m != nil {
+		return m.RotatedEthereumAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnrefundedContractCallTxs() []*ContractCallTx {
+	if m != nil {
+		return m.UnrefundedContractCallTxs
+	}
+	return nil
+}
+
+// RotatedEthereumAddress is the Ethereum address a validator keeps signing
+// outgoing txs with while a rotation of its Ethereum key is pending
+type RotatedEthereumAddress struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EthereumAddress  string `protobuf:"bytes,2,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+}
+
+func (m *RotatedEthereumAddress) Reset()         { *m = RotatedEthereumAddress{} }
+func (m *RotatedEthereumAddress) String() string { return proto.CompactTextString(m) }
+func (*RotatedEthereumAddress) ProtoMessage()    {}
+func (*RotatedEthereumAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *RotatedEthereumAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotatedEthereumAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotatedEthereumAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotatedEthereumAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotatedEthereumAddress.Merge(m, src)
+}
+func (m *RotatedEthereumAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotatedEthereumAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotatedEthereumAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotatedEthereumAddress proto.InternalMessageInfo
+
+func (m *RotatedEthereumAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RotatedEthereumAddress) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
+}
+
 // This records the relationship between an ERC20 token and the denom
-// of the corresponding Cosmos originated asset
+// of the corresponding Cosmos originated asset, and the decimal shift amounts
+// are scaled by when moving between them
 type ERC20ToDenom struct {
-	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Erc20        string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	DecimalShift int32  `protobuf:"varint,3,opt,name=decimal_shift,json=decimalShift,proto3" json:"decimal_shift,omitempty"`
 }
 
 func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
 func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenom) ProtoMessage()    {}
 func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+	return fileDescriptor_387b0aba880adb60, []int{3}
 }
 func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,73 +516,103 @@ func (m *ERC20ToDenom) GetDenom() string {
 	return ""
 }
 
+func (m *ERC20ToDenom) GetDecimalShift() int32 {
+	if m != nil {
+		return m.DecimalShift
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*RotatedEthereumAddress)(nil), "gravity.v1.RotatedEthereumAddress")
 	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
 }
 
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xb6, 0xa9, 0x1b, 0xda, 0xb1, 0x4d, 0xca, 0xe0, 0xc0, 0xd6, 0x29, 0xae, 0x09, 0xa2, 0x0a,
-	0x88, 0xec, 0x26, 0xae, 0x04, 0x22, 0xfc, 0xa8, 0xcd, 0x0f, 0x50, 0x21, 0x28, 0x5a, 0x1b, 0x90,
-	0xb8, 0x60, 0x18, 0xef, 0x9c, 0x8c, 0x97, 0xd8, 0x33, 0xd1, 0xce, 0xac, 0x6b, 0xdf, 0xf1, 0x08,
-	0x7d, 0x16, 0x1e, 0x81, 0xab, 0x5e, 0xf6, 0x12, 0x21, 0x54, 0xa1, 0xe4, 0x45, 0xd0, 0xfc, 0xac,
-	0xff, 0x52, 0x6e, 0x72, 0xb5, 0x9e, 0xf9, 0xbe, 0xef, 0x9c, 0x6f, 0xce, 0xf1, 0x9c, 0x41, 0x01,
-	0xcf, 0xe8, 0x38, 0xd5, 0xd3, 0x68, 0xbc, 0x17, 0x71, 0x10, 0xa0, 0x52, 0x15, 0x9e, 0x65, 0x52,
-	0x4b, 0x8c, 0x3c, 0x12, 0x8e, 0xf7, 0x9a, 0x0d, 0x2e, 0xb9, 0xb4, 0xdb, 0x91, 0xf9, 0xe5, 0x18,
-	0xcd, 0x25, 0xad, 0x27, 0x3b, 0x64, 0x63, 0x01, 0x19, 0x29, 0xee, 0x43, 0x36, 0x6f, 0x73, 0x29,
-	0xf9, 0x10, 0x22, 0xbb, 0xea, 0xe7, 0x27, 0x11, 0x15, 0x5e, 0xb1, 0xf5, 0xe7, 0x0d, 0xb4, 0xf6,
-	0x3d, 0xcd, 0xe8, 0x48, 0xe1, 0xb7, 0x51, 0x91, 0x9a, 0xa4, 0x2c, 0x28, 0xb7, 0xcb, 0xdb, 0x37,
-	0xe3, 0x9b, 0x7e, 0xe7, 0x11, 0xc3, 0xbb, 0xa8, 0x91, 0x48, 0xa1, 0x33, 0x9a, 0x68, 0xa2, 0x64,
-	0x9e, 0x25, 0x40, 0x06, 0x54, 0x0d, 0x82, 0x57, 0x2c, 0x11, 0x17, 0x58, 0xd7, 0x42, 0x5f, 0x53,
-	0x35, 0xc0, 0x1f, 0xa1, 0xb7, 0xfa, 0x59, 0xca, 0x38, 0x10, 0xd0, 0x03, 0xc8, 0x20, 0x1f, 0x11,
-	0xca, 0x58, 0x06, 0x4a, 0x05, 0x15, 0x2b, 0xda, 0x70, 0xf0, 0xb1, 0x47, 0x1f, 0x3a, 0x10, 0xdf,
-	0x43, 0xeb, 0x5e, 0x97, 0x0c, 0x68, 0x2a, 0x8c, 0x9b, 0xeb, 0xed, 0xf2, 0x76, 0x25, 0xae, 0xbb,
-	0xed, 0x43, 0xb3, 0xfb, 0x88, 0xe1, 0x2f, 0xd0, 0x1d, 0x95, 0x72, 0x01, 0x8c, 0xd8, 0x4f, 0x46,
-	0x14, 0x68, 0xa2, 0x27, 0x8a, 0x3c, 0x49, 0x05, 0x93, 0x4f, 0x82, 0x35, 0x2b, 0x0a, 0x1c, 0xa7,
-	0x6b, 0x29, 0x5d, 0xd0, 0xbd, 0x89, 0xfa, 0xc9, 0xe2, 0xb8, 0x83, 0x36, 0xbc, 0xbe, 0x4f, 0x75,
-	0x32, 0x80, 0x99, 0xf0, 0x55, 0x2b, 0x7c, 0xc3, 0x81, 0x07, 0x0e, 0xf3, 0x9a, 0xcf, 0x50, 0x73,
-	0x76, 0x18, 0x83, 0x53, 0x9d, 0x67, 0x73, 0xe1, 0x0d, 0x97, 0xb1, 0x60, 0x74, 0x67, 0x04, 0xaf,
-	0xde, 0x43, 0x1b, 0x9a, 0x66, 0x1c, 0xb4, 0xa9, 0x08, 0xd1, 0x13, 0xa2, 0xd3, 0x11, 0xc8, 0x5c,
-	0x07, 0xc8, 0x0a, 0xb1, 0x03, 0x8f, 0xf5, 0xa0, 0x37, 0xe9, 0x39, 0x04, 0x7f, 0x88, 0x30, 0x1d,
-	0x43, 0x46, 0x39, 0x90, 0xfe, 0x50, 0x26, 0xa7, 0x56, 0x12, 0x54, 0x2d, 0xff, 0x96, 0x47, 0x0e,
-	0x0c, 0x60, 0x04, 0xf8, 0x73, 0xb4, 0x59, 0xb0, 0x67, 0x36, 0x17, 0x64, 0x35, 0xe7, 0xcf, 0x53,
-	0x8a, 0xba, 0xcf, 0xe5, 0x02, 0xdd, 0x51, 0x43, 0xaa, 0x06, 0xe4, 0xc4, 0xb4, 0x32, 0x95, 0x62,
-	0xb9, 0xb2, 0x41, 0xbd, 0x5d, 0xde, 0xae, 0x1d, 0x84, 0xcf, 0x5e, 0xdc, 0x2d, 0xfd, 0xfd, 0xe2,
-	0xee, 0x3d, 0x9e, 0xea, 0x41, 0xde, 0x0f, 0x13, 0x39, 0x8a, 0x12, 0xa9, 0x46, 0x52, 0xf9, 0xcf,
-	0x8e, 0x62, 0xa7, 0x91, 0x9e, 0x9e, 0x81, 0x0a, 0x8f, 0x20, 0x89, 0x03, 0x1b, 0xf3, 0x4b, 0x1f,
-	0x72, 0xa1, 0x11, 0xf8, 0x57, 0xd4, 0x58, 0xc9, 0x67, 0x3b, 0x11, 0xbc, 0x76, 0xa5, 0x3c, 0x78,
-	0x29, 0x8f, 0xed, 0x1b, 0x9e, 0xa2, 0x77, 0x56, 0x32, 0x5c, 0x6e, 0x5f, 0xb0, 0x7e, 0xa5, 0x74,
-	0xad, 0xa5, 0x74, 0xc7, 0xab, 0x3d, 0xc7, 0x4f, 0xcb, 0x68, 0x67, 0x25, 0x77, 0x22, 0xc5, 0xc9,
-	0x30, 0x4d, 0x74, 0x2a, 0xf8, 0xcb, 0x7c, 0xdc, 0xba, 0x92, 0x8f, 0xf7, 0x97, 0x7c, 0x1c, 0xce,
-	0x53, 0x5c, 0xb6, 0xf4, 0x18, 0xbd, 0x97, 0x8b, 0xbe, 0x14, 0x8c, 0x58, 0x8d, 0xb1, 0xf1, 0xf2,
-	0xab, 0xf3, 0xba, 0xfd, 0xa3, 0xb4, 0x1d, 0xb9, 0xeb, 0xb9, 0x97, 0xaf, 0xd0, 0x7e, 0xe5, 0xf7,
-	0x7f, 0xda, 0xa5, 0xad, 0x3f, 0x2a, 0xa8, 0xf6, 0x95, 0x1b, 0x62, 0x5d, 0x4d, 0x35, 0xe0, 0x0f,
-	0xd0, 0xda, 0x99, 0x1d, 0x2a, 0x76, 0x8c, 0x54, 0x3b, 0x38, 0x9c, 0x0f, 0xb5, 0xd0, 0x8d, 0x9b,
-	0xd8, 0x33, 0xf0, 0x27, 0xe8, 0xf6, 0x90, 0x2a, 0x4d, 0x64, 0x5f, 0x41, 0x36, 0x06, 0x46, 0x60,
-	0x0c, 0x42, 0x13, 0x21, 0x45, 0x02, 0x76, 0xb8, 0x54, 0xe2, 0x37, 0x0d, 0xe1, 0xb1, 0xc7, 0x8f,
-	0x0d, 0xfc, 0x9d, 0x41, 0xf1, 0xc7, 0xa8, 0x26, 0x73, 0xcd, 0xa5, 0x39, 0x87, 0x9e, 0xa8, 0xe0,
-	0x5a, 0xfb, 0xda, 0x76, 0xb5, 0xd3, 0x08, 0xdd, 0xb8, 0x0b, 0x8b, 0x71, 0x17, 0x3e, 0x14, 0xd3,
-	0xb8, 0x5a, 0x30, 0x7b, 0x13, 0x85, 0xf7, 0x51, 0xdd, 0xb4, 0x22, 0xcd, 0x46, 0xd4, 0xd4, 0xcc,
-	0xcc, 0xa3, 0xff, 0x57, 0x2e, 0x53, 0x71, 0x1f, 0x6d, 0xce, 0x5a, 0xe7, 0xac, 0x8e, 0xa5, 0x06,
-	0x92, 0x41, 0x22, 0x33, 0xa6, 0x82, 0x9b, 0x36, 0xd2, 0xbb, 0x8b, 0x07, 0x2e, 0xfa, 0x60, 0x9d,
-	0xff, 0x28, 0x35, 0xc4, 0x96, 0x3b, 0x9f, 0x13, 0x2b, 0x80, 0xc2, 0x0f, 0x50, 0x9d, 0xc1, 0x10,
-	0x38, 0xd5, 0x40, 0x4e, 0x61, 0xaa, 0x02, 0x64, 0xa3, 0x6e, 0x2e, 0x46, 0xfd, 0x56, 0xf1, 0x23,
-	0xcf, 0xf9, 0x06, 0xa6, 0x2a, 0xae, 0xb1, 0x85, 0x15, 0x7e, 0x80, 0xd6, 0x21, 0x4b, 0x3a, 0xbb,
-	0x44, 0x4b, 0xc2, 0x40, 0xc8, 0x91, 0x0a, 0xaa, 0x36, 0x46, 0xb0, 0xe4, 0x2c, 0x3e, 0xec, 0xec,
-	0xf6, 0xe4, 0x91, 0x21, 0xc4, 0x75, 0x2b, 0xf0, 0x2b, 0x85, 0x7f, 0x41, 0xad, 0x5c, 0xb8, 0xc1,
-	0xc8, 0x88, 0x02, 0xc1, 0x4c, 0xa8, 0xd9, 0xc9, 0x4d, 0xb9, 0x6b, 0x36, 0x60, 0x73, 0x31, 0x60,
-	0x17, 0x04, 0xeb, 0xc9, 0xe2, 0xc0, 0x71, 0x73, 0x16, 0x61, 0x19, 0xe8, 0x4d, 0xd4, 0xd6, 0x3e,
-	0xaa, 0x2d, 0xa6, 0xc7, 0x0d, 0x74, 0xdd, 0x1a, 0xf0, 0x2f, 0x8f, 0x5b, 0x98, 0x5d, 0x6b, 0xdf,
-	0x3f, 0x33, 0x6e, 0x71, 0xf0, 0xc3, 0xb3, 0xf3, 0x56, 0xf9, 0xf9, 0x79, 0xab, 0xfc, 0xef, 0x79,
-	0xab, 0xfc, 0xf4, 0xa2, 0x55, 0x7a, 0x7e, 0xd1, 0x2a, 0xfd, 0x75, 0xd1, 0x2a, 0xfd, 0xfc, 0xe9,
-	0xc2, 0xa5, 0x39, 0x03, 0xce, 0xa7, 0xbf, 0x8d, 0x8b, 0x37, 0x72, 0xc7, 0xbd, 0x1e, 0xd1, 0x48,
-	0xb2, 0x7c, 0x08, 0xd1, 0xf8, 0x7e, 0x34, 0x29, 0x20, 0x77, 0x9b, 0xfa, 0x6b, 0xb6, 0xef, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x74, 0xfe, 0x63, 0x78, 0x9d, 0x07, 0x00, 0x00,
+	// 1249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x6f, 0x6f, 0xdb, 0x44,
+	0x18, 0x6f, 0x58, 0xd7, 0xb1, 0x6b, 0xba, 0x76, 0xd7, 0xb4, 0xf5, 0xd2, 0x91, 0x85, 0x0e, 0xa6,
+	0x0e, 0x58, 0xb2, 0x75, 0x12, 0x88, 0x0d, 0xd0, 0xd6, 0xac, 0xb0, 0x09, 0x41, 0x27, 0x27, 0x80,
+	0x04, 0x12, 0xb7, 0x8b, 0xfd, 0xd4, 0x36, 0xb3, 0x7d, 0xd5, 0xdd, 0x39, 0x4b, 0x5e, 0x20, 0xf1,
+	0x11, 0xc6, 0xb7, 0xda, 0xcb, 0xbd, 0x44, 0x08, 0x4d, 0x68, 0xfb, 0x14, 0xbc, 0x43, 0xf7, 0xc7,
+	0x8e, 0x9d, 0x14, 0x21, 0xf5, 0x55, 0x72, 0xf7, 0xfb, 0xf3, 0x3c, 0xf7, 0x3c, 0x77, 0xbe, 0x43,
+	0x4e, 0xc0, 0xe9, 0x28, 0x92, 0x93, 0xee, 0xe8, 0x56, 0x37, 0x80, 0x14, 0x44, 0x24, 0x3a, 0xc7,
+	0x9c, 0x49, 0x86, 0x91, 0x45, 0x3a, 0xa3, 0x5b, 0xcd, 0x46, 0xc0, 0x02, 0xa6, 0xa7, 0xbb, 0xea,
+	0x9f, 0x61, 0x34, 0x2b, 0x5a, 0x4b, 0x36, 0xc8, 0x46, 0x09, 0x49, 0x44, 0x60, 0x2d, 0x9b, 0x97,
+	0x02, 0xc6, 0x82, 0x18, 0xba, 0x7a, 0x34, 0xcc, 0x8e, 0xba, 0x34, 0xb5, 0x8a, 0x9d, 0xdf, 0xeb,
+	0x68, 0xe9, 0x31, 0xe5, 0x34, 0x11, 0xf8, 0x1d, 0x94, 0x87, 0x26, 0x91, 0xef, 0xd4, 0xda, 0xb5,
+	0xdd, 0xf3, 0xee, 0x79, 0x3b, 0xf3, 0xc8, 0xc7, 0x37, 0x51, 0xc3, 0x63, 0xa9, 0xe4, 0xd4, 0x93,
+	0x44, 0xb0, 0x8c, 0x7b, 0x40, 0x42, 0x2a, 0x42, 0xe7, 0x2d, 0x4d, 0xc4, 0x39, 0xd6, 0xd7, 0xd0,
+	0x43, 0x2a, 0x42, 0xfc, 0x31, 0xda, 0x1a, 0xf2, 0xc8, 0x0f, 0x80, 0x80, 0x0c, 0x81, 0x43, 0x96,
+	0x10, 0xea, 0xfb, 0x1c, 0x84, 0x70, 0x16, 0xb5, 0x68, 0xc3, 0xc0, 0x07, 0x16, 0xbd, 0x6f, 0x40,
+	0x7c, 0x0d, 0xad, 0x5a, 0x9d, 0x17, 0xd2, 0x28, 0x55, 0xd9, 0x9c, 0x6d, 0xd7, 0x76, 0x17, 0xdd,
+	0x15, 0x33, 0xdd, 0x53, 0xb3, 0x8f, 0x7c, 0xfc, 0x05, 0xba, 0x2c, 0xa2, 0x20, 0x05, 0x9f, 0xe8,
+	0x1f, 0x4e, 0x04, 0x48, 0x22, 0xc7, 0x82, 0x3c, 0x8b, 0x52, 0x9f, 0x3d, 0x73, 0x96, 0xb4, 0xc8,
+	0x31, 0x9c, 0xbe, 0xa6, 0xf4, 0x41, 0x0e, 0xc6, 0xe2, 0x07, 0x8d, 0xe3, 0x3d, 0xb4, 0x61, 0xf5,
+	0x43, 0x2a, 0xbd, 0x10, 0x0a, 0xe1, 0x39, 0x2d, 0x5c, 0x37, 0xe0, 0xbe, 0xc1, 0xac, 0xe6, 0x33,
+	0xd4, 0x2c, 0x16, 0xa3, 0x70, 0x2a, 0x33, 0x3e, 0x15, 0xbe, 0x6d, 0x22, 0xe6, 0x8c, 0x7e, 0x41,
+	0xb0, 0xea, 0x5b, 0x68, 0x43, 0x52, 0x1e, 0x80, 0x54, 0x15, 0x21, 0x72, 0x4c, 0x64, 0x94, 0x00,
+	0xcb, 0xa4, 0x83, 0xb4, 0x10, 0x1b, 0xf0, 0x40, 0x86, 0x83, 0xf1, 0xc0, 0x20, 0xf8, 0x23, 0x84,
+	0xe9, 0x08, 0x38, 0x0d, 0x80, 0x0c, 0x63, 0xe6, 0x3d, 0xd5, 0x12, 0x67, 0x59, 0xf3, 0xd7, 0x2c,
+	0xb2, 0xaf, 0x00, 0x25, 0xc0, 0x9f, 0xa3, 0xed, 0x9c, 0x5d, 0xa4, 0x59, 0x92, 0xd5, 0x4d, 0x7e,
+	0x96, 0x92, 0xd7, 0x7d, 0x2a, 0x4f, 0xd1, 0x65, 0x11, 0x53, 0x11, 0x92, 0x23, 0xd5, 0xca, 0x88,
+	0xa5, 0xd5, 0xca, 0x3a, 0x2b, 0xed, 0xda, 0x6e, 0x7d, 0xbf, 0xf3, 0xe2, 0xd5, 0x95, 0x85, 0x3f,
+	0x5f, 0x5d, 0xb9, 0x16, 0x44, 0x32, 0xcc, 0x86, 0x1d, 0x8f, 0x25, 0x5d, 0x8f, 0x89, 0x84, 0x09,
+	0xfb, 0x73, 0x43, 0xf8, 0x4f, 0xbb, 0x72, 0x72, 0x0c, 0xa2, 0xf3, 0x00, 0x3c, 0xd7, 0xd1, 0x9e,
+	0x5f, 0x5a, 0xcb, 0x52, 0x23, 0xf0, 0x13, 0xd4, 0x98, 0x89, 0xa7, 0x3b, 0xe1, 0x5c, 0x38, 0x55,
+	0x1c, 0x5c, 0x89, 0xa3, 0xfb, 0x86, 0x27, 0xe8, 0xdd, 0x99, 0x08, 0xf3, 0xed, 0x73, 0x56, 0x4f,
+	0x15, 0xae, 0x55, 0x09, 0x77, 0x30, 0xdb, 0x73, 0xfc, 0xbc, 0x86, 0x6e, 0xcc, 0xc4, 0xf6, 0x58,
+	0x7a, 0x14, 0x47, 0x9e, 0x8c, 0xd2, 0xe0, 0xa4, 0x3c, 0xd6, 0x4e, 0x95, 0xc7, 0xf5, 0x4a, 0x1e,
+	0xbd, 0x69, 0x88, 0xf9, 0x94, 0x0e, 0xd1, 0xfb, 0x59, 0x3a, 0x64, 0xa9, 0x4f, 0xb4, 0x46, 0xa5,
+	0x71, 0xf2, 0xd1, 0xb9, 0xa8, 0x37, 0x4a, 0xdb, 0x90, 0xfb, 0x96, 0x7b, 0xc2, 0x11, 0xfa, 0x15,
+	0xbd, 0x57, 0x31, 0x20, 0xc7, 0xec, 0x19, 0x70, 0x75, 0x6e, 0xd3, 0x00, 0x88, 0x0c, 0x39, 0x88,
+	0x90, 0xc5, 0xbe, 0x83, 0x4f, 0xb5, 0xb2, 0x2b, 0x62, 0x1a, 0xf1, 0xb1, 0x32, 0xee, 0x69, 0xdf,
+	0x41, 0x6e, 0x8b, 0x0f, 0x50, 0x3b, 0xa1, 0xe3, 0xea, 0x1a, 0xec, 0x7e, 0x8f, 0x52, 0x09, 0x7c,
+	0x44, 0x63, 0x67, 0x5d, 0x2f, 0x65, 0x3b, 0xa1, 0xe3, 0x52, 0xfe, 0x7a, 0xcb, 0x3f, 0xb2, 0x14,
+	0x0c, 0x68, 0x2b, 0x89, 0x2a, 0x7b, 0xdd, 0x63, 0xe6, 0x88, 0x38, 0x8d, 0x53, 0x25, 0xde, 0x48,
+	0xa2, 0xe9, 0x3e, 0xef, 0x59, 0x2f, 0xec, 0xa1, 0xcd, 0x52, 0xb6, 0xa6, 0x52, 0x22, 0xa4, 0x1c,
+	0x9c, 0x8d, 0x53, 0x45, 0x59, 0x2f, 0xd6, 0xa4, 0x8b, 0xd3, 0x57, 0x56, 0xf8, 0x2e, 0x6a, 0xc2,
+	0x18, 0xbc, 0x4c, 0x82, 0xaf, 0x8a, 0x11, 0x46, 0x42, 0x32, 0x3e, 0xc9, 0xfb, 0xba, 0xa9, 0x8b,
+	0xb1, 0x95, 0x33, 0x06, 0xe3, 0x87, 0x06, 0x37, 0xed, 0xbc, 0xb3, 0xf8, 0xdb, 0x5f, 0xed, 0x85,
+	0x9d, 0x7f, 0xce, 0xa1, 0xfa, 0x57, 0xe6, 0x4e, 0xea, 0x4b, 0x2a, 0x01, 0x7f, 0x80, 0x96, 0x8e,
+	0xf5, 0x1d, 0xa1, 0x6f, 0x85, 0xe5, 0x3d, 0xdc, 0x99, 0xde, 0x51, 0x1d, 0x73, 0x7b, 0xb8, 0x96,
+	0x81, 0x3f, 0x45, 0x97, 0x62, 0x2a, 0x24, 0x61, 0x43, 0x01, 0x7c, 0x04, 0x3e, 0x81, 0x11, 0xa4,
+	0x92, 0xa4, 0x2c, 0xf5, 0x40, 0xdf, 0x15, 0x8b, 0xee, 0xa6, 0x22, 0x1c, 0x5a, 0xfc, 0x40, 0xc1,
+	0xdf, 0x2a, 0x14, 0x7f, 0x82, 0xea, 0x2c, 0x93, 0x01, 0x53, 0xdb, 0x52, 0x8e, 0x85, 0x73, 0xa6,
+	0x7d, 0x66, 0x77, 0x79, 0xaf, 0xd1, 0x31, 0xb7, 0x57, 0x27, 0xbf, 0xbd, 0x3a, 0xf7, 0xd3, 0x89,
+	0xbb, 0x9c, 0x33, 0x07, 0x63, 0x81, 0xef, 0xa0, 0x15, 0x75, 0xb2, 0x22, 0x9e, 0x50, 0x75, 0x04,
+	0xd4, 0xf5, 0xf2, 0xdf, 0xca, 0x2a, 0x15, 0x0f, 0xd1, 0x76, 0x71, 0x12, 0x4d, 0xaa, 0x23, 0x26,
+	0x81, 0x70, 0xf0, 0x18, 0xf7, 0x85, 0x73, 0x5e, 0x3b, 0x5d, 0x2d, 0x2f, 0x38, 0x3f, 0x56, 0x3a,
+	0xf3, 0xef, 0x99, 0x04, 0x57, 0x73, 0xa7, 0x9f, 0xfd, 0x19, 0x40, 0xe0, 0x7b, 0x68, 0xc5, 0x87,
+	0x18, 0x02, 0x2a, 0x81, 0x3c, 0x85, 0x89, 0x70, 0x90, 0x76, 0xdd, 0x2e, 0xbb, 0x7e, 0x23, 0x82,
+	0x07, 0x96, 0xf3, 0x35, 0x4c, 0x84, 0x5b, 0xf7, 0x4b, 0x23, 0x7c, 0x0f, 0xad, 0x02, 0xf7, 0xf6,
+	0x6e, 0x12, 0xc9, 0x88, 0x0f, 0x29, 0x4b, 0x84, 0xb3, 0xac, 0x3d, 0x9c, 0x4a, 0x66, 0x6e, 0x6f,
+	0xef, 0xe6, 0x80, 0x3d, 0x50, 0x04, 0x77, 0x45, 0x0b, 0xec, 0x48, 0xe0, 0x9f, 0x51, 0x2b, 0x4b,
+	0xcd, 0x3d, 0xe7, 0x13, 0x01, 0xa9, 0xaf, 0xac, 0x8a, 0x95, 0xab, 0x72, 0xd7, 0xb5, 0x61, 0xb3,
+	0x6c, 0xd8, 0x87, 0xd4, 0x1f, 0xb0, 0x7c, 0xc1, 0x6e, 0xb3, 0x70, 0xa8, 0x02, 0xaa, 0x07, 0x14,
+	0x35, 0xcd, 0x26, 0x35, 0xf9, 0x11, 0x0e, 0x41, 0x24, 0x24, 0xb7, 0x0d, 0x59, 0x99, 0x2f, 0x63,
+	0x4f, 0xb3, 0x4d, 0xae, 0x25, 0xae, 0xeb, 0x78, 0x27, 0x03, 0x02, 0xbb, 0x68, 0xa3, 0xd8, 0xda,
+	0x95, 0x8d, 0x72, 0x41, 0xbb, 0xb7, 0x2a, 0xa5, 0xb0, 0xc4, 0xc3, 0x62, 0x9b, 0xb8, 0xeb, 0x30,
+	0x37, 0x27, 0xf0, 0x21, 0xda, 0xe2, 0x20, 0x23, 0xae, 0x36, 0xea, 0x4c, 0x81, 0x57, 0xff, 0xa7,
+	0xc0, 0x0d, 0x2b, 0x3c, 0xa8, 0xd4, 0xf9, 0x09, 0x6a, 0x72, 0xa6, 0x4e, 0x8d, 0x3f, 0xf7, 0xea,
+	0x01, 0xe1, 0xac, 0x69, 0xcf, 0x9d, 0xb2, 0xa7, 0x6b, 0xd8, 0x33, 0x8f, 0x20, 0xd7, 0xe1, 0x27,
+	0xce, 0x83, 0xc0, 0x3f, 0xa1, 0xcb, 0x59, 0xca, 0xe1, 0x28, 0x4b, 0x7d, 0xf0, 0x49, 0xf1, 0x26,
+	0xf3, 0x68, 0x1c, 0xeb, 0x6a, 0x5c, 0x9c, 0xef, 0x63, 0xcf, 0x92, 0x7a, 0x34, 0x8e, 0x07, 0x63,
+	0xf7, 0xd2, 0x54, 0x5f, 0x45, 0xc4, 0xce, 0x31, 0xda, 0x3c, 0x39, 0x21, 0xfc, 0x21, 0xba, 0x38,
+	0xa2, 0x71, 0xe4, 0x53, 0xc9, 0x78, 0xf1, 0x8e, 0x33, 0xaf, 0xc4, 0xb5, 0x02, 0xc8, 0xc9, 0xd7,
+	0xd1, 0xda, 0xdc, 0x9b, 0xcf, 0x3c, 0x14, 0x57, 0xa1, 0xea, 0xbb, 0x43, 0x50, 0xbd, 0x5c, 0x56,
+	0xdc, 0x40, 0x67, 0x75, 0x27, 0xac, 0xb7, 0x19, 0xa8, 0x59, 0xdd, 0x16, 0xeb, 0x62, 0x06, 0xf8,
+	0xaa, 0x3a, 0x58, 0x5e, 0x94, 0xd0, 0x98, 0x88, 0x30, 0x3a, 0x92, 0xce, 0x99, 0x76, 0x6d, 0xf7,
+	0xac, 0x5b, 0xb7, 0x93, 0x7d, 0x35, 0xb7, 0xff, 0xdd, 0x8b, 0xd7, 0xad, 0xda, 0xcb, 0xd7, 0xad,
+	0xda, 0xdf, 0xaf, 0x5b, 0xb5, 0xe7, 0x6f, 0x5a, 0x0b, 0x2f, 0xdf, 0xb4, 0x16, 0xfe, 0x78, 0xd3,
+	0x5a, 0xf8, 0xf1, 0x6e, 0xe9, 0x43, 0x7b, 0x0c, 0x41, 0x30, 0xf9, 0x65, 0x94, 0x3f, 0xa8, 0x6f,
+	0x98, 0xa7, 0x66, 0x37, 0x61, 0x7e, 0x16, 0x43, 0x77, 0x74, 0xbb, 0x3b, 0xce, 0x21, 0xf3, 0x05,
+	0x1e, 0x2e, 0xe9, 0xaf, 0xca, 0xed, 0x7f, 0x07, 0x00, 0xe1, 0x39, 0x12, 0x2f, 0xca, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -461,6 +635,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutedTxHistoryWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutedTxHistoryWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.MaxSignerPowerShare.Size()
+		i -= size
+		if _, err := m.MaxSignerPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MinSignerSetCoverage.Size()
+		i -= size
+		if _, err := m.MinSignerSetCoverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.MaxSignerSetTxBlockInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSignerSetTxBlockInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.SignerSetTxPowerChangeThreshold.Size()
+		i -= size
+		if _, err := m.SignerSetTxPowerChangeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
 		i--
@@ -589,6 +813,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnrefundedContractCallTxs) > 0 {
+		for iNdEx := len(m.UnrefundedContractCallTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnrefundedContractCallTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RotatedEthereumAddresses) > 0 {
+		for iNdEx := len(m.RotatedEthereumAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotatedEthereumAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RetiredErc20ToDenoms) > 0 {
+		for iNdEx := len(m.RetiredErc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetiredErc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ExecutedOutgoingTxs) > 0 {
+		for iNdEx := len(m.ExecutedOutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedOutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CosmosDenomRegistrations) > 0 {
+		for iNdEx := len(m.CosmosDenomRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CosmosDenomRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.UnbatchedSendToEthereumTxs) > 0 {
 		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -693,6 +991,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RotatedEthereumAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotatedEthereumAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotatedEthereumAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -713,6 +1048,11 @@ func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecimalShift != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DecimalShift))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -791,6 +1131,18 @@ func (m *Params) Size() (n int) {
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
 	}
+	l = m.SignerSetTxPowerChangeThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.MaxSignerSetTxBlockInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxSignerSetTxBlockInterval))
+	}
+	l = m.MinSignerSetCoverage.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.MaxSignerPowerShare.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ExecutedTxHistoryWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.ExecutedTxHistoryWindow))
+	}
 	return n
 }
 
@@ -843,6 +1195,53 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CosmosDenomRegistrations) > 0 {
+		for _, e := range m.CosmosDenomRegistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedOutgoingTxs) > 0 {
+		for _, e := range m.ExecutedOutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetiredErc20ToDenoms) > 0 {
+		for _, e := range m.RetiredErc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RotatedEthereumAddresses) > 0 {
+		for _, e := range m.RotatedEthereumAddresses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnrefundedContractCallTxs) > 0 {
+		for _, e := range m.UnrefundedContractCallTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RotatedEthereumAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -860,6 +1259,9 @@ func (m *ERC20ToDenom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.DecimalShift != 0 {
+		n += 1 + sovGenesis(uint64(m.DecimalShift))
+	}
 	return n
 }
 
@@ -1278,14 +1680,151 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxPowerChangeThreshold", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSetTxPowerChangeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignerSetTxBlockInterval", wireType)
+			}
+			m.MaxSignerSetTxBlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSignerSetTxBlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignerSetCoverage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignerSetCoverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignerPowerShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSignerPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedTxHistoryWindow", wireType)
+			}
+			m.ExecutedTxHistoryWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedTxHistoryWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -1587,6 +2126,290 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenomRegistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenomRegistrations = append(m.CosmosDenomRegistrations, &CosmosDenomRegistration{})
+			if err := m.CosmosDenomRegistrations[len(m.CosmosDenomRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedOutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedOutgoingTxs = append(m.ExecutedOutgoingTxs, &ExecutedOutgoingTx{})
+			if err := m.ExecutedOutgoingTxs[len(m.ExecutedOutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredErc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredErc20ToDenoms = append(m.RetiredErc20ToDenoms, &ERC20ToDenom{})
+			if err := m.RetiredErc20ToDenoms[len(m.RetiredErc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedEthereumAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotatedEthereumAddresses = append(m.RotatedEthereumAddresses, &RotatedEthereumAddress{})
+			if err := m.RotatedEthereumAddresses[len(m.RotatedEthereumAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrefundedContractCallTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrefundedContractCallTxs = append(m.UnrefundedContractCallTxs, &ContractCallTx{})
+			if err := m.UnrefundedContractCallTxs[len(m.UnrefundedContractCallTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotatedEthereumAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotatedEthereumAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotatedEthereumAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1701,6 +2524,25 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalShift", wireType)
+			}
+			m.DecimalShift = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecimalShift |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -88,6 +88,43 @@ func TestGenesisStateValidate(t *testing.T) {
 				},
 			},
 		}, expErr: true},
+		"executed outgoing tx with bad sender": {src: &GenesisState{
+			Params: DefaultParams(),
+			ExecutedOutgoingTxs: []*ExecutedOutgoingTx{
+				{
+					StoreIndex:     []byte{BatchTxPrefixByte, 1},
+					TokenContracts: []string{"0xFDb0aaBD40774BBF3068Bf29E8b0a6C88BE26F83"},
+					Senders:        []string{"cosmos1wrong"},
+				},
+			},
+		}, expErr: true},
+		"executed outgoing tx with bad token contract": {src: &GenesisState{
+			Params: DefaultParams(),
+			ExecutedOutgoingTxs: []*ExecutedOutgoingTx{
+				{
+					StoreIndex:     []byte{BatchTxPrefixByte, 1},
+					TokenContracts: []string{"0xdeadbeef"},
+				},
+			},
+		}, expErr: true},
+		"rotated ethereum address": {src: &GenesisState{
+			Params: DefaultParams(),
+			RotatedEthereumAddresses: []*RotatedEthereumAddress{
+				{ValidatorAddress: "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z", EthereumAddress: "0xFDb0aaBD40774BBF3068Bf29E8b0a6C88BE26F83"},
+			},
+		}, expErr: false},
+		"rotated ethereum address with bad validator": {src: &GenesisState{
+			Params: DefaultParams(),
+			RotatedEthereumAddresses: []*RotatedEthereumAddress{
+				{ValidatorAddress: "cosmos1wrong", EthereumAddress: "0xFDb0aaBD40774BBF3068Bf29E8b0a6C88BE26F83"},
+			},
+		}, expErr: true},
+		"rotated ethereum address with bad ethereum address": {src: &GenesisState{
+			Params: DefaultParams(),
+			RotatedEthereumAddresses: []*RotatedEthereumAddress{
+				{ValidatorAddress: "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z", EthereumAddress: "0xdeadbeef"},
+			},
+		}, expErr: true},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -10,6 +10,14 @@ type GravityHooks interface {
 	AfterSendToCosmosEvent(ctx sdk.Context, event SendToCosmosEvent)
 }
 
+// SendToCosmosReceiver is implemented by modules registered with the gravity
+// keeper to receive deposits to their module account. OnSendToCosmos is called
+// once the deposited coins are in the module account, a failure is discarded
+// and leaves the coins there.
+type SendToCosmosReceiver interface {
+	OnSendToCosmos(ctx sdk.Context, event SendToCosmosEvent, coins sdk.Coins) error
+}
+
 type MultiGravityHooks []GravityHooks
 
 func NewMultiGravityHooks(hooks ...GravityHooks) MultiGravityHooks {
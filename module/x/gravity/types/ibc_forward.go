@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// IBCForward is an ICS-20 transfer of a deposit on to a receiver on another
+// chain. It is encoded in the cosmos receiver of a SendToCosmosEvent as
+//
+//	{local_address}|{port}/{channel}:{receiver}
+//
+// where the local address receives the deposit, sends the transfer and gets
+// the coins back if the transfer fails.
+type IBCForward struct {
+	Port     string
+	Channel  string
+	Receiver string
+}
+
+// SplitCosmosReceiver splits the cosmos receiver of a SendToCosmosEvent into
+// the local address and the encoded IBC forward, which is empty if there is
+// none
+func SplitCosmosReceiver(cosmosReceiver string) (localAddress, forward string) {
+	if i := strings.Index(cosmosReceiver, "|"); i >= 0 {
+		return cosmosReceiver[:i], cosmosReceiver[i+1:]
+	}
+	return cosmosReceiver, ""
+}
+
+// ParseIBCForward parses an IBC forward encoded as {port}/{channel}:{receiver}
+func ParseIBCForward(forward string) (*IBCForward, error) {
+	route, receiver, ok := strings.Cut(forward, ":")
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "IBC forward %q is not {port}/{channel}:{receiver}", forward)
+	}
+	port, channel, ok := strings.Cut(route, "/")
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "IBC forward %q is not {port}/{channel}:{receiver}", forward)
+	}
+
+	f := &IBCForward{Port: port, Channel: channel, Receiver: receiver}
+	if err := f.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ValidateBasic performs stateless checks on validity
+func (f *IBCForward) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(f.Port); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(f.Channel); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if strings.TrimSpace(f.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalid, "IBC receiver cannot be blank")
+	}
+	return nil
+}
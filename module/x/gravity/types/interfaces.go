@@ -37,4 +37,5 @@ type OutgoingTx interface {
 	GetCheckpoint([]byte) []byte
 	GetStoreIndex() []byte
 	GetCosmosHeight() uint64
+	GetRelayCalldata(*SignerSetTx, []ABIEncodedValSignature) ([]byte, error)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -62,6 +63,39 @@ const (
 
 	// EthereumHeightVoteKey indexes the latest heights observed by each validator
 	EthereumHeightVoteKey
+
+	// EthereumValidatorAddressKey is the reverse index of ValidatorEthereumAddressKey
+	EthereumValidatorAddressKey
+
+	// OrchestratorEthereumAddressKey is the reverse index of EthereumOrchestratorAddressKey
+	OrchestratorEthereumAddressKey
+
+	// ExecutedOutgoingTxKey indexes the records of executed outgoing txs by cosmos height
+	ExecutedOutgoingTxKey
+
+	// ExecutedOutgoingTxByTokenKey indexes executed outgoing tx records by token contract
+	ExecutedOutgoingTxByTokenKey
+
+	// ExecutedOutgoingTxBySenderKey indexes executed outgoing tx records by send to ethereum sender
+	ExecutedOutgoingTxBySenderKey
+
+	// CosmosDenomRegistrationKey prefixes the Cosmos originated denoms approved for ERC20 deployment
+	CosmosDenomRegistrationKey
+
+	// DenomDecimalShiftKey prefixes the decimal shift between a Cosmos originated denom and its ERC20
+	DenomDecimalShiftKey
+
+	// LastCommunityPoolContractCallNonceKey indexes the last invalidation nonce of community pool contract calls
+	LastCommunityPoolContractCallNonceKey
+
+	// RotatedEthereumAddressKey prefixes the Ethereum address a validator rotated away from while it is still in the last observed signer set
+	RotatedEthereumAddressKey
+
+	// RetiredERC20ToDenomKey prefixes the ERC20s of Cosmos originated denoms replaced or removed by governance
+	RetiredERC20ToDenomKey
+
+	// UnrefundedContractCallTxKey prefixes the canceled community pool contract calls whose refund failed
+	UnrefundedContractCallTxKey
 )
 
 ////////////////////
@@ -86,6 +120,24 @@ func MakeEthereumOrchestratorAddressKey(eth common.Address) []byte {
 	return append([]byte{EthereumOrchestratorAddressKey}, eth.Bytes()...)
 }
 
+// MakeEthereumValidatorAddressKey returns the following key format
+// [0x15][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeEthereumValidatorAddressKey(eth common.Address) []byte {
+	return append([]byte{EthereumValidatorAddressKey}, eth.Bytes()...)
+}
+
+// MakeOrchestratorEthereumAddressKey returns the following key format
+// [0x16][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeOrchestratorEthereumAddressKey(orc sdk.AccAddress) []byte {
+	return append([]byte{OrchestratorEthereumAddressKey}, orc.Bytes()...)
+}
+
+// MakeRotatedEthereumAddressKey returns the following key format
+// [0x1d][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeRotatedEthereumAddressKey(validator sdk.ValAddress) []byte {
+	return append([]byte{RotatedEthereumAddressKey}, validator.Bytes()...)
+}
+
 /////////////////////////
 // Ethereum Signatures //
 /////////////////////////
@@ -117,6 +169,31 @@ func MakeOutgoingTxKey(storeIndex []byte) []byte {
 	return append([]byte{OutgoingTxKey}, storeIndex...)
 }
 
+///////////////////////////
+// Executed Outgoing Txs //
+///////////////////////////
+
+// MakeExecutedOutgoingTxKey returns the following key format
+// prefix     cosmos-height                 store-index
+// [0x17][0 0 0 0 0 0 0 1][0x01 0xc783df8a850f42e7F7e57013759C285caa701eB6 0 0 0 0 0 0 0 1]
+func MakeExecutedOutgoingTxKey(cosmosHeight uint64, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{{ExecutedOutgoingTxKey}, sdk.Uint64ToBigEndian(cosmosHeight), storeIndex}, []byte{})
+}
+
+// MakeExecutedOutgoingTxByTokenPrefix returns the following key format
+// prefix              token-contract
+// [0x18][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeExecutedOutgoingTxByTokenPrefix(tokenContract common.Address) []byte {
+	return append([]byte{ExecutedOutgoingTxByTokenKey}, tokenContract.Bytes()...)
+}
+
+// MakeExecutedOutgoingTxBySenderPrefix returns the following key format
+// prefix  length                sender
+// [0x19][20][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeExecutedOutgoingTxBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append([]byte{ExecutedOutgoingTxBySenderKey}, address.MustLengthPrefix(sender.Bytes())...)
+}
+
 //////////////////////
 // Send To Ethereum //
 //////////////////////
@@ -145,6 +222,22 @@ func MakeERC20ToDenomKey(erc20 common.Address) []byte {
 	return append([]byte{ERC20ToDenomKey}, erc20.Bytes()...)
 }
 
+func MakeRetiredERC20ToDenomKey(erc20 common.Address) []byte {
+	return append([]byte{RetiredERC20ToDenomKey}, erc20.Bytes()...)
+}
+
+func MakeUnrefundedContractCallTxKey(invalidationNonce uint64) []byte {
+	return append([]byte{UnrefundedContractCallTxKey}, sdk.Uint64ToBigEndian(invalidationNonce)...)
+}
+
+func MakeCosmosDenomRegistrationKey(denom string) []byte {
+	return append([]byte{CosmosDenomRegistrationKey}, []byte(denom)...)
+}
+
+func MakeDenomDecimalShiftKey(denom string) []byte {
+	return append([]byte{DenomDecimalShiftKey}, []byte(denom)...)
+}
+
 func MakeSignerSetTxKey(nonce uint64) []byte {
 	return append([]byte{SignerSetTxPrefixByte}, sdk.Uint64ToBigEndian(nonce)...)
 }
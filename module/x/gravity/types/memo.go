@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EthereumForwardMemo is the memo of an ICS-20 transfer whose tokens are sent
+// on to Ethereum once received, e.g.
+//
+//	{"gravity":{"ethereum_recipient":"0x...","bridge_fee":"100"}}
+type EthereumForwardMemo struct {
+	Gravity *EthereumForward `json:"gravity"`
+}
+
+// EthereumForward is the Ethereum recipient of forwarded tokens and the part
+// of the transferred amount paid as bridge fee
+type EthereumForward struct {
+	EthereumRecipient string `json:"ethereum_recipient"`
+	BridgeFee         string `json:"bridge_fee"`
+}
+
+// ParseEthereumForwardMemo returns the Ethereum forward of an ICS-20 transfer
+// memo, or false if the memo does not request one
+func ParseEthereumForwardMemo(memo string) (*EthereumForward, bool, error) {
+	var m EthereumForwardMemo
+	if err := json.Unmarshal([]byte(memo), &m); err != nil || m.Gravity == nil {
+		return nil, false, nil
+	}
+
+	if err := m.Gravity.ValidateBasic(); err != nil {
+		return nil, true, err
+	}
+
+	return m.Gravity, true, nil
+}
+
+// ValidateBasic performs stateless checks on validity
+func (f *EthereumForward) ValidateBasic() error {
+	if !common.IsHexAddress(f.EthereumRecipient) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid ethereum recipient %s", f.EthereumRecipient)
+	}
+	if _, err := f.Fee(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Fee returns the bridge fee, zero if unset
+func (f *EthereumForward) Fee() (sdk.Int, error) {
+	if f.BridgeFee == "" {
+		return sdk.ZeroInt(), nil
+	}
+
+	fee, ok := sdk.NewIntFromString(f.BridgeFee)
+	if !ok || fee.IsNegative() {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalid, "invalid bridge fee %s", f.BridgeFee)
+	}
+	return fee, nil
+}
@@ -11,15 +11,20 @@ import (
 
 var (
 	_ sdk.Msg = &MsgDelegateKeys{}
+	_ sdk.Msg = &MsgRotateDelegateKeys{}
 	_ sdk.Msg = &MsgSendToEthereum{}
 	_ sdk.Msg = &MsgCancelSendToEthereum{}
 	_ sdk.Msg = &MsgRequestBatchTx{}
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
+	_ sdk.Msg = &MsgSubmitEthereumEvents{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
+	_ sdk.Msg = &MsgSubmitEthereumTxConfirmations{}
 	_ sdk.Msg = &MsgEthereumHeightVote{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvents{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmations{}
 	_ cdctypes.UnpackInterfacesMessage = &EthereumEventVoteRecord{}
 )
 
@@ -71,6 +76,54 @@ func (msg MsgDelegateKeys) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(acc)}
 }
 
+// NewMsgRotateDelegateKeys returns a reference to a new MsgRotateDelegateKeys.
+func NewMsgRotateDelegateKeys(val sdk.ValAddress, orchAddr sdk.AccAddress, ethAddr string, ethSig []byte) *MsgRotateDelegateKeys {
+	return &MsgRotateDelegateKeys{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orchAddr.String(),
+		EthereumAddress:     ethAddr,
+		EthSignature:        ethSig,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRotateDelegateKeys) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRotateDelegateKeys) Type() string { return "rotate_delegate_keys" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRotateDelegateKeys) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
+	}
+	if !common.IsHexAddress(msg.EthereumAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	}
+	if len(msg.EthSignature) == 0 {
+		return ErrEmptyEthSig
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRotateDelegateKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRotateDelegateKeys) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
 // Route should return the name of the module
 func (msg MsgSubmitEthereumEvent) Route() string { return RouterKey }
 
@@ -110,6 +163,65 @@ func (msg MsgSubmitEthereumEvent) UnpackInterfaces(unpacker cdctypes.AnyUnpacker
 	return unpacker.UnpackAny(msg.Event, &event)
 }
 
+// Route should return the name of the module
+func (msg MsgSubmitEthereumEvents) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSubmitEthereumEvents) Type() string { return "submit_ethereum_events" }
+
+// ValidateBasic performs stateless checks, including that the event nonces
+// are contiguous and increasing
+func (msg MsgSubmitEthereumEvents) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if len(msg.Events) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no events")
+	}
+
+	var lastNonce uint64
+	for i, eventAny := range msg.Events {
+		event, err := UnpackEvent(eventAny)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "event %d", i)
+		}
+		if err = event.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "event %d", i)
+		}
+		if i > 0 && event.GetEventNonce() != lastNonce+1 {
+			return sdkerrors.Wrapf(ErrInvalid,
+				"non contiguous event nonce at event %d expected %d observed %d", i, lastNonce+1, event.GetEventNonce())
+		}
+		lastNonce = event.GetEventNonce()
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitEthereumEvents) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitEthereumEvents) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg MsgSubmitEthereumEvents) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, eventAny := range msg.Events {
+		var event EthereumEvent
+		if err := unpacker.UnpackAny(eventAny, &event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Route should return the name of the module
 func (msg MsgSubmitEthereumTxConfirmation) Route() string { return RouterKey }
 
@@ -151,6 +263,52 @@ func (msg MsgSubmitEthereumTxConfirmation) UnpackInterfaces(unpacker cdctypes.An
 	return unpacker.UnpackAny(msg.Confirmation, &sig)
 }
 
+// Route should return the name of the module
+func (msg MsgSubmitEthereumTxConfirmations) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSubmitEthereumTxConfirmations) Type() string { return "submit_ethereum_signatures" }
+
+// ValidateBasic performs stateless checks. The confirmations themselves are
+// validated one by one when the message is handled.
+func (msg MsgSubmitEthereumTxConfirmations) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if len(msg.Confirmations) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no confirmations")
+	}
+	if len(msg.Confirmations) > MaxEthereumTxConfirmations {
+		return sdkerrors.Wrapf(ErrInvalid, "%d confirmations exceed the maximum of %d", len(msg.Confirmations), MaxEthereumTxConfirmations)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitEthereumTxConfirmations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitEthereumTxConfirmations) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg MsgSubmitEthereumTxConfirmations) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, confirmation := range msg.Confirmations {
+		var sig EthereumTxConfirmation
+		if err := unpacker.UnpackAny(confirmation, &sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewMsgSendToEthereum returns a new MsgSendToEthereum
 func NewMsgSendToEthereum(sender sdk.AccAddress, destAddress string, send sdk.Coin, bridgeFee sdk.Coin) *MsgSendToEthereum {
 	return &MsgSendToEthereum{
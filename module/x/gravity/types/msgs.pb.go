@@ -613,6 +613,150 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitEthereumTxConfirmationResponse proto.InternalMessageInfo
 
+// MsgSubmitEthereumTxConfirmations submits many ethereum signatures for a given
+// validator at once. Each confirmation is processed independently, so an
+// invalid one does not prevent the others from being stored.
+type MsgSubmitEthereumTxConfirmations struct {
+	Confirmations []*types1.Any `protobuf:"bytes,1,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Signer        string        `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSubmitEthereumTxConfirmations) Reset()         { *m = MsgSubmitEthereumTxConfirmations{} }
+func (m *MsgSubmitEthereumTxConfirmations) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumTxConfirmations) ProtoMessage()    {}
+func (*MsgSubmitEthereumTxConfirmations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
+}
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEthereumTxConfirmations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmations.Merge(m, src)
+}
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEthereumTxConfirmations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEthereumTxConfirmations proto.InternalMessageInfo
+
+// EthereumTxConfirmationResult is the outcome of a single confirmation of a
+// MsgSubmitEthereumTxConfirmations
+type EthereumTxConfirmationResult struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// reason the confirmation was rejected, empty on success
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EthereumTxConfirmationResult) Reset()         { *m = EthereumTxConfirmationResult{} }
+func (m *EthereumTxConfirmationResult) String() string { return proto.CompactTextString(m) }
+func (*EthereumTxConfirmationResult) ProtoMessage()    {}
+func (*EthereumTxConfirmationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
+}
+func (m *EthereumTxConfirmationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumTxConfirmationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumTxConfirmationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumTxConfirmationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumTxConfirmationResult.Merge(m, src)
+}
+func (m *EthereumTxConfirmationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumTxConfirmationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumTxConfirmationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumTxConfirmationResult proto.InternalMessageInfo
+
+func (m *EthereumTxConfirmationResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EthereumTxConfirmationResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// MsgSubmitEthereumTxConfirmationsResponse holds a result for each submitted
+// confirmation, in the order they were submitted
+type MsgSubmitEthereumTxConfirmationsResponse struct {
+	Results []*EthereumTxConfirmationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Reset() {
+	*m = MsgSubmitEthereumTxConfirmationsResponse{}
+}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumTxConfirmationsResponse) ProtoMessage()    {}
+func (*MsgSubmitEthereumTxConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
+}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse.Merge(m, src)
+}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) GetResults() []*EthereumTxConfirmationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // MsgSubmitEthereumEvent
 type MsgSubmitEthereumEvent struct {
 	Event  *types1.Any `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -623,7 +767,7 @@ func (m *MsgSubmitEthereumEvent) Reset()         { *m = MsgSubmitEthereumEvent{}
 func (m *MsgSubmitEthereumEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEvent) ProtoMessage()    {}
 func (*MsgSubmitEthereumEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgSubmitEthereumEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +803,7 @@ func (m *MsgSubmitEthereumEventResponse) Reset()         { *m = MsgSubmitEthereu
 func (m *MsgSubmitEthereumEventResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEventResponse) ProtoMessage()    {}
 func (*MsgSubmitEthereumEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgSubmitEthereumEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,6 +832,83 @@ func (m *MsgSubmitEthereumEventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitEthereumEventResponse proto.InternalMessageInfo
 
+// MsgSubmitEthereumEvents submits a list of events with contiguous, increasing
+// event nonces. The events are recorded in order, and either all of them or
+// none are recorded.
+type MsgSubmitEthereumEvents struct {
+	Events []*types1.Any `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Signer string        `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSubmitEthereumEvents) Reset()         { *m = MsgSubmitEthereumEvents{} }
+func (m *MsgSubmitEthereumEvents) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumEvents) ProtoMessage()    {}
+func (*MsgSubmitEthereumEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
+}
+func (m *MsgSubmitEthereumEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEthereumEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEthereumEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEthereumEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumEvents.Merge(m, src)
+}
+func (m *MsgSubmitEthereumEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEthereumEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEthereumEvents proto.InternalMessageInfo
+
+type MsgSubmitEthereumEventsResponse struct {
+}
+
+func (m *MsgSubmitEthereumEventsResponse) Reset()         { *m = MsgSubmitEthereumEventsResponse{} }
+func (m *MsgSubmitEthereumEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumEventsResponse) ProtoMessage()    {}
+func (*MsgSubmitEthereumEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEthereumEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumEventsResponse.Merge(m, src)
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEthereumEventsResponse proto.InternalMessageInfo
+
 // MsgDelegateKey allows validators to delegate their voting responsibilities
 // to a given orchestrator address. This key is then used as an optional
 // authentication method for attesting events from Ethereum.
@@ -702,7 +923,7 @@ func (m *MsgDelegateKeys) Reset()         { *m = MsgDelegateKeys{} }
 func (m *MsgDelegateKeys) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeys) ProtoMessage()    {}
 func (*MsgDelegateKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *MsgDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -766,7 +987,7 @@ func (m *MsgDelegateKeysResponse) Reset()         { *m = MsgDelegateKeysResponse
 func (m *MsgDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeysResponse) ProtoMessage()    {}
 func (*MsgDelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +1028,7 @@ func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
 func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysSignMsg) ProtoMessage()    {}
 func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
 func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,6 +1071,115 @@ func (m *DelegateKeysSignMsg) GetNonce() uint64 {
 	return 0
 }
 
+// MsgRotateDelegateKeys allows a validator that has already delegated its keys
+// to replace its orchestrator address, its Ethereum address, or both. Either
+// address may be left unchanged by resubmitting the one in use. The
+// eth_signature must be made by the key of the resulting ethereum_address over
+// a DelegateKeysSignMsg, as for MsgDelegateKeys.
+type MsgRotateDelegateKeys struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	EthereumAddress     string `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	EthSignature        []byte `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+}
+
+func (m *MsgRotateDelegateKeys) Reset()         { *m = MsgRotateDelegateKeys{} }
+func (m *MsgRotateDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeys) ProtoMessage()    {}
+func (*MsgRotateDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
+}
+func (m *MsgRotateDelegateKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeys.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeys proto.InternalMessageInfo
+
+func (m *MsgRotateDelegateKeys) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetEthSignature() []byte {
+	if m != nil {
+		return m.EthSignature
+	}
+	return nil
+}
+
+type MsgRotateDelegateKeysResponse struct {
+}
+
+func (m *MsgRotateDelegateKeysResponse) Reset()         { *m = MsgRotateDelegateKeysResponse{} }
+func (m *MsgRotateDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeysResponse) ProtoMessage()    {}
+func (*MsgRotateDelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeysResponse proto.InternalMessageInfo
+
 // Periodic update of latest observed Ethereum and Cosmos heights from the
 // orchestrator
 type MsgEthereumHeightVote struct {
@@ -861,7 +1191,7 @@ func (m *MsgEthereumHeightVote) Reset()         { *m = MsgEthereumHeightVote{} }
 func (m *MsgEthereumHeightVote) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumHeightVote) ProtoMessage()    {}
 func (*MsgEthereumHeightVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
 func (m *MsgEthereumHeightVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1241,7 @@ func (m *MsgEthereumHeightVoteResponse) Reset()         { *m = MsgEthereumHeight
 func (m *MsgEthereumHeightVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumHeightVoteResponse) ProtoMessage()    {}
 func (*MsgEthereumHeightVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *MsgEthereumHeightVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,15 +1278,20 @@ type SendToCosmosEvent struct {
 	TokenContract  string                                 `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	EthereumSender string                                 `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
-	CosmosReceiver string                                 `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
-	EthereumHeight uint64                                 `protobuf:"varint,6,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// local address receiving the deposit, optionally followed by an IBC
+	// forward of it, as {local_address}|{port}/{channel}:{receiver}
+	CosmosReceiver string `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	EthereumHeight uint64 `protobuf:"varint,6,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// metadata of an Ethereum originated ERC20, read from the token contract by
+	// orchestrators, used to register bank metadata for its voucher denom
+	Erc20Metadata *ERC20Metadata `protobuf:"bytes,7,opt,name=erc20_metadata,json=erc20Metadata,proto3" json:"erc20_metadata,omitempty"`
 }
 
 func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
 func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
 func (*SendToCosmosEvent) ProtoMessage()    {}
 func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,6 +1355,74 @@ func (m *SendToCosmosEvent) GetEthereumHeight() uint64 {
 	return 0
 }
 
+func (m *SendToCosmosEvent) GetErc20Metadata() *ERC20Metadata {
+	if m != nil {
+		return m.Erc20Metadata
+	}
+	return nil
+}
+
+// ERC20Metadata is the name, symbol and decimals of an ERC20 token
+type ERC20Metadata struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol   string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *ERC20Metadata) Reset()         { *m = ERC20Metadata{} }
+func (m *ERC20Metadata) String() string { return proto.CompactTextString(m) }
+func (*ERC20Metadata) ProtoMessage()    {}
+func (*ERC20Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
+}
+func (m *ERC20Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Metadata.Merge(m, src)
+}
+func (m *ERC20Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Metadata proto.InternalMessageInfo
+
+func (m *ERC20Metadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ERC20Metadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ERC20Metadata) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 // BatchExecutedEvent claims that a batch of BatchTxExecutedal operations on the
 // bridge contract was executed successfully on ETH
 type BatchExecutedEvent struct {
@@ -1033,7 +1436,7 @@ func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
 func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*BatchExecutedEvent) ProtoMessage()    {}
 func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1506,7 @@ func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedE
 func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractCallExecutedEvent) ProtoMessage()    {}
 func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
 func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1579,7 @@ func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
 func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
 func (*ERC20DeployedEvent) ProtoMessage()    {}
 func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
 }
 func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1670,7 @@ func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEve
 func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
 func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
 }
 func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,14 +1739,22 @@ func init() {
 	proto.RegisterType((*BatchTxConfirmation)(nil), "gravity.v1.BatchTxConfirmation")
 	proto.RegisterType((*SignerSetTxConfirmation)(nil), "gravity.v1.SignerSetTxConfirmation")
 	proto.RegisterType((*MsgSubmitEthereumTxConfirmationResponse)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmationResponse")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmations)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmations")
+	proto.RegisterType((*EthereumTxConfirmationResult)(nil), "gravity.v1.EthereumTxConfirmationResult")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmationsResponse)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmationsResponse")
 	proto.RegisterType((*MsgSubmitEthereumEvent)(nil), "gravity.v1.MsgSubmitEthereumEvent")
 	proto.RegisterType((*MsgSubmitEthereumEventResponse)(nil), "gravity.v1.MsgSubmitEthereumEventResponse")
+	proto.RegisterType((*MsgSubmitEthereumEvents)(nil), "gravity.v1.MsgSubmitEthereumEvents")
+	proto.RegisterType((*MsgSubmitEthereumEventsResponse)(nil), "gravity.v1.MsgSubmitEthereumEventsResponse")
 	proto.RegisterType((*MsgDelegateKeys)(nil), "gravity.v1.MsgDelegateKeys")
 	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "gravity.v1.MsgDelegateKeysResponse")
 	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
+	proto.RegisterType((*MsgRotateDelegateKeys)(nil), "gravity.v1.MsgRotateDelegateKeys")
+	proto.RegisterType((*MsgRotateDelegateKeysResponse)(nil), "gravity.v1.MsgRotateDelegateKeysResponse")
 	proto.RegisterType((*MsgEthereumHeightVote)(nil), "gravity.v1.MsgEthereumHeightVote")
 	proto.RegisterType((*MsgEthereumHeightVoteResponse)(nil), "gravity.v1.MsgEthereumHeightVoteResponse")
 	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
+	proto.RegisterType((*ERC20Metadata)(nil), "gravity.v1.ERC20Metadata")
 	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
 	proto.RegisterType((*ContractCallExecutedEvent)(nil), "gravity.v1.ContractCallExecutedEvent")
 	proto.RegisterType((*ERC20DeployedEvent)(nil), "gravity.v1.ERC20DeployedEvent")
@@ -1353,91 +1764,105 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x25, 0xd9, 0x81, 0x47, 0xfe, 0xa5, 0x9d, 0x44, 0x56, 0x12, 0xc9, 0x51, 0x90, 0xc6,
-	0x69, 0x20, 0x32, 0x76, 0x02, 0xb4, 0x48, 0xd1, 0x02, 0x96, 0xec, 0x20, 0x45, 0xe1, 0x1c, 0x24,
-	0xa7, 0x30, 0x7a, 0x11, 0x28, 0x72, 0x42, 0x31, 0x11, 0xb9, 0x2a, 0x77, 0x25, 0x58, 0xd7, 0x9e,
-	0x8a, 0x9e, 0xda, 0x43, 0xef, 0x39, 0x04, 0x7d, 0x82, 0xbc, 0x40, 0x6e, 0x69, 0x4e, 0x01, 0x0a,
-	0x14, 0x45, 0x0f, 0x41, 0x11, 0x5f, 0xfa, 0x0c, 0x05, 0x0a, 0x14, 0xdc, 0x5d, 0xca, 0x24, 0x45,
-	0xff, 0x01, 0x3d, 0x89, 0x3b, 0xf3, 0xed, 0xfc, 0xed, 0xb7, 0x3b, 0x23, 0xb8, 0x68, 0xfb, 0xc6,
-	0xd0, 0x61, 0x23, 0x7d, 0xb8, 0xa1, 0xbb, 0xd4, 0xa6, 0x5a, 0xdf, 0x27, 0x8c, 0xa8, 0x20, 0xc5,
-	0xda, 0x70, 0xa3, 0x54, 0x36, 0x09, 0x75, 0x09, 0xd5, 0x3b, 0x06, 0x45, 0x7d, 0xb8, 0xd1, 0x41,
-	0x66, 0x6c, 0xe8, 0x26, 0x71, 0x3c, 0x81, 0x2d, 0xad, 0x0a, 0x7d, 0x9b, 0xaf, 0x74, 0xb1, 0x90,
-	0xaa, 0x62, 0xc4, 0x7a, 0x68, 0x51, 0x68, 0x56, 0x6c, 0x62, 0x13, 0xb1, 0x23, 0xf8, 0x92, 0xd2,
-	0xab, 0x36, 0x21, 0x76, 0x0f, 0x75, 0xa3, 0xef, 0xe8, 0x86, 0xe7, 0x11, 0x66, 0x30, 0x87, 0x78,
-	0xa1, 0xb5, 0x55, 0xa9, 0xe5, 0xab, 0xce, 0xe0, 0xa9, 0x6e, 0x78, 0xd2, 0x5c, 0xf5, 0x37, 0x05,
-	0x96, 0x76, 0xa9, 0xdd, 0x42, 0xcf, 0xda, 0x23, 0x3b, 0xac, 0x8b, 0x3e, 0x0e, 0x5c, 0xf5, 0x12,
-	0x4c, 0x53, 0xf4, 0x2c, 0xf4, 0x8b, 0xca, 0x9a, 0xb2, 0x3e, 0xd3, 0x94, 0x2b, 0xb5, 0x06, 0x2a,
-	0x4a, 0x4c, 0xdb, 0x47, 0xd3, 0xe9, 0x3b, 0xe8, 0xb1, 0x62, 0x96, 0x63, 0x96, 0x42, 0x4d, 0x33,
-	0x54, 0xa8, 0x9f, 0xc0, 0xb4, 0xe1, 0x92, 0x81, 0xc7, 0x8a, 0xb9, 0x35, 0x65, 0xbd, 0xb0, 0xb9,
-	0xaa, 0xc9, 0x24, 0x83, 0x8a, 0x68, 0xb2, 0x22, 0x5a, 0x83, 0x38, 0x5e, 0x3d, 0xff, 0xe6, 0x7d,
-	0x25, 0xd3, 0x94, 0x70, 0xf5, 0x0b, 0x80, 0x8e, 0xef, 0x58, 0x36, 0xb6, 0x9f, 0x22, 0x16, 0xf3,
-	0x67, 0xdb, 0x3c, 0x23, 0xb6, 0x3c, 0x44, 0xac, 0xde, 0x81, 0xd5, 0x89, 0xa4, 0x9a, 0x48, 0xfb,
-	0xc4, 0xa3, 0xa8, 0xce, 0x43, 0xd6, 0xb1, 0x78, 0x62, 0xf9, 0x66, 0xd6, 0xb1, 0xaa, 0x5b, 0x70,
-	0x79, 0x97, 0xda, 0x0d, 0xc3, 0x33, 0xb1, 0x97, 0xa8, 0x43, 0x02, 0x1a, 0xa9, 0x4b, 0x36, 0x5a,
-	0x97, 0xea, 0x75, 0xa8, 0x1c, 0x63, 0x22, 0xf4, 0x5a, 0xdd, 0xe2, 0x75, 0x6e, 0xe2, 0xb7, 0x03,
-	0xa4, 0xac, 0x6e, 0x30, 0xb3, 0xbb, 0x77, 0xa0, 0xae, 0xc0, 0x94, 0x85, 0x1e, 0x71, 0x65, 0x99,
-	0xc5, 0x82, 0x7b, 0x71, 0x6c, 0x2f, 0xe2, 0x85, 0xaf, 0xaa, 0x57, 0x78, 0x56, 0x71, 0x13, 0x63,
-	0xfb, 0x3f, 0x2b, 0x3c, 0x86, 0xd6, 0xa0, 0xe3, 0x3a, 0x2c, 0xf4, 0xbe, 0x77, 0xd0, 0x20, 0xde,
-	0x53, 0xc7, 0x77, 0x39, 0x1d, 0xd4, 0x3d, 0x98, 0x35, 0x23, 0x6b, 0xee, 0xb5, 0xb0, 0xb9, 0xa2,
-	0x09, 0x7a, 0x68, 0x21, 0x3d, 0xb4, 0x2d, 0x6f, 0x54, 0x2f, 0xbd, 0x7d, 0x55, 0xbb, 0x94, 0x6e,
-	0xa7, 0x19, 0xb3, 0x72, 0x5c, 0xb8, 0x0f, 0xf2, 0xdf, 0xbf, 0xa8, 0x64, 0xaa, 0xaf, 0x15, 0x28,
-	0x35, 0x88, 0xc7, 0x7c, 0xc3, 0x64, 0x0d, 0xa3, 0xd7, 0x4b, 0x84, 0x54, 0x03, 0xd5, 0xf1, 0x86,
-	0x46, 0xcf, 0xb1, 0xf8, 0xba, 0x4d, 0x4d, 0xd2, 0x47, 0x1e, 0xd8, 0x6c, 0x73, 0x29, 0xaa, 0x69,
-	0x05, 0x8a, 0x09, 0xb8, 0x47, 0x3c, 0x13, 0xb9, 0xdf, 0x7c, 0x1c, 0xfe, 0x38, 0x50, 0xa8, 0xb7,
-	0x60, 0x61, 0xcc, 0x57, 0x19, 0x63, 0x8e, 0xc7, 0x38, 0x1f, 0x8a, 0x5b, 0x5c, 0xaa, 0x5e, 0x85,
-	0x99, 0x40, 0x6f, 0xb0, 0x81, 0x2f, 0xf8, 0x36, 0xdb, 0x3c, 0x12, 0x54, 0x5f, 0x2a, 0xb0, 0x2c,
-	0xeb, 0x1d, 0x0b, 0xfe, 0x26, 0xcc, 0x33, 0xf2, 0x1c, 0xbd, 0xb6, 0x29, 0x13, 0x94, 0xe7, 0x38,
-	0xc7, 0xa5, 0x61, 0xd6, 0x6a, 0x05, 0x0a, 0x9d, 0x60, 0x77, 0x2c, 0x5a, 0xe0, 0xa2, 0xff, 0x35,
-	0xcc, 0x1f, 0x14, 0xb8, 0x2c, 0x80, 0x2d, 0x64, 0x89, 0x50, 0xd7, 0x61, 0x51, 0x58, 0x6e, 0x53,
-	0x64, 0x32, 0x10, 0xc1, 0xeb, 0x79, 0x1a, 0x6e, 0x39, 0x36, 0x98, 0xec, 0xe9, 0xc1, 0xe4, 0x92,
-	0xc1, 0xdc, 0x86, 0x5b, 0xa7, 0xd0, 0x71, 0x4c, 0xdd, 0x01, 0x5c, 0x9a, 0x80, 0xee, 0x0c, 0x83,
-	0x07, 0xe4, 0x73, 0x98, 0xc2, 0xe0, 0xe3, 0x44, 0xa6, 0x2e, 0xbd, 0x7d, 0x55, 0x9b, 0x8b, 0xed,
-	0x6b, 0x8a, 0x5d, 0xa7, 0x30, 0x73, 0x0d, 0xca, 0xe9, 0x6e, 0xc7, 0x81, 0xbd, 0x56, 0x60, 0x61,
-	0x97, 0xda, 0xdb, 0xd8, 0x43, 0xdb, 0x60, 0xf8, 0x15, 0x8e, 0xa8, 0x7a, 0x07, 0x96, 0x24, 0xcb,
-	0x88, 0xdf, 0x36, 0x2c, 0xcb, 0x47, 0x4a, 0xe5, 0xb1, 0x2f, 0x8e, 0x15, 0x5b, 0x42, 0xae, 0x6e,
-	0xc0, 0x0a, 0xf1, 0xcd, 0x2e, 0x52, 0xe6, 0xc7, 0xf0, 0x22, 0x9c, 0xe5, 0xa8, 0x2e, 0xdc, 0x72,
-	0x1b, 0x16, 0xc7, 0xe5, 0x0f, 0xe1, 0x82, 0x0c, 0xe3, 0x63, 0x09, 0xa1, 0x37, 0x60, 0x0e, 0x59,
-	0xb7, 0x9d, 0x64, 0xc4, 0x2c, 0xb2, 0x6e, 0x6b, 0x7c, 0x0e, 0xab, 0xfc, 0x75, 0x8b, 0xa6, 0x30,
-	0x4e, 0x6f, 0x1f, 0x96, 0xa3, 0xf2, 0x60, 0xcf, 0x2e, 0xb5, 0xcf, 0x97, 0xe1, 0x0a, 0x4c, 0x45,
-	0x59, 0x2d, 0x16, 0xd5, 0x7d, 0xb8, 0xb8, 0x4b, 0xed, 0xb0, 0xa8, 0x8f, 0xd0, 0xb1, 0xbb, 0xec,
-	0x6b, 0xc2, 0xe2, 0xe4, 0xea, 0x72, 0x71, 0xc8, 0x42, 0x8c, 0x81, 0x8f, 0x7d, 0x03, 0x2b, 0x70,
-	0x2d, 0xd5, 0xf2, 0x38, 0xa9, 0x97, 0x59, 0x58, 0x12, 0x4f, 0x70, 0x83, 0xb7, 0x0b, 0x41, 0xa4,
-	0x0a, 0x14, 0x38, 0x25, 0x62, 0xcc, 0x07, 0x2e, 0x12, 0xac, 0x9f, 0xbc, 0xca, 0xd9, 0xb4, 0xab,
-	0xfc, 0x30, 0xd6, 0xd1, 0x66, 0xea, 0x5a, 0xd0, 0x79, 0xfe, 0x7c, 0x5f, 0xf9, 0xc8, 0x76, 0x58,
-	0x77, 0xd0, 0xd1, 0x4c, 0xe2, 0xca, 0x46, 0x2e, 0x7f, 0x6a, 0xd4, 0x7a, 0xae, 0xb3, 0x51, 0x1f,
-	0xa9, 0xf6, 0xa5, 0xc7, 0xc6, 0x0d, 0x2e, 0x76, 0xc9, 0x44, 0x47, 0xc9, 0x27, 0x2e, 0x99, 0xe8,
-	0xb8, 0xb7, 0x60, 0x41, 0x4e, 0x09, 0x3e, 0x9a, 0xe8, 0x0c, 0xd1, 0x2f, 0x4e, 0x09, 0xa0, 0x10,
-	0x37, 0xa5, 0x34, 0xad, 0xb2, 0xd3, 0x69, 0x95, 0x7d, 0x90, 0xff, 0xfb, 0x45, 0x45, 0xa9, 0xfe,
-	0xa2, 0x80, 0xca, 0x9f, 0xb4, 0x9d, 0x03, 0x34, 0x07, 0x0c, 0x2d, 0x51, 0xa7, 0xb3, 0xbf, 0x68,
-	0xd1, 0x72, 0x66, 0x27, 0xca, 0x99, 0x12, 0x4d, 0x2e, 0xf5, 0x9c, 0x13, 0x6f, 0x63, 0x3e, 0xf9,
-	0x36, 0x56, 0xff, 0x55, 0x60, 0x35, 0xda, 0x3f, 0xe2, 0xf1, 0x9e, 0x7a, 0xae, 0x76, 0x6a, 0x7f,
-	0x09, 0x02, 0x9e, 0xad, 0x7f, 0xfa, 0xcf, 0xfb, 0xca, 0xfd, 0xc8, 0xc1, 0x31, 0x5e, 0x72, 0xd7,
-	0xf1, 0x58, 0xf4, 0xb3, 0xe7, 0x74, 0xa8, 0xde, 0x19, 0x31, 0xa4, 0xda, 0x23, 0x3c, 0xa8, 0x07,
-	0x1f, 0x67, 0xef, 0x4c, 0xb9, 0xb3, 0x74, 0x26, 0x59, 0xa0, 0x7c, 0x5a, 0x81, 0xaa, 0x3f, 0x65,
-	0x41, 0xdd, 0x69, 0x36, 0x36, 0xef, 0x6e, 0x63, 0xbf, 0x47, 0x46, 0x67, 0x4e, 0xfc, 0x7a, 0xd0,
-	0xeb, 0x39, 0x71, 0xc4, 0x84, 0x21, 0xe8, 0x5c, 0x10, 0xb2, 0x6d, 0x3e, 0x67, 0x4c, 0x1e, 0x76,
-	0x2e, 0xed, 0xb0, 0xaf, 0x01, 0xa0, 0x6f, 0x6e, 0xde, 0x6d, 0x7b, 0x86, 0x8b, 0x92, 0xa6, 0x33,
-	0x5c, 0xf2, 0xd8, 0x70, 0xb9, 0x23, 0xa1, 0xa6, 0x23, 0xb7, 0x43, 0x7a, 0x92, 0x9e, 0x05, 0x2e,
-	0x6b, 0x71, 0x51, 0xe0, 0x48, 0x40, 0x2c, 0x34, 0x1d, 0xd7, 0xe8, 0x51, 0x49, 0xcd, 0x39, 0x2e,
-	0xdd, 0x96, 0xc2, 0xb4, 0x9a, 0x5c, 0x48, 0xad, 0xc9, 0xaf, 0x0a, 0x14, 0x23, 0x8d, 0xee, 0x9c,
-	0x94, 0xa8, 0xc1, 0x72, 0xa4, 0x15, 0xb2, 0x83, 0x18, 0x89, 0x17, 0xe9, 0x91, 0xdd, 0x73, 0x52,
-	0xf9, 0x3e, 0x5c, 0x70, 0xd1, 0xed, 0xa0, 0x4f, 0x8b, 0xf9, 0xb5, 0xdc, 0x7a, 0x61, 0xb3, 0xa4,
-	0x1d, 0xfd, 0x19, 0xd0, 0x76, 0x62, 0xcd, 0xb3, 0x19, 0x42, 0x37, 0x7f, 0x9f, 0x82, 0x5c, 0xf0,
-	0xea, 0xee, 0xc3, 0x7c, 0x62, 0xf8, 0xbc, 0x16, 0xdd, 0x3e, 0x31, 0xce, 0x96, 0x6e, 0x9e, 0xa8,
-	0x1e, 0xbf, 0x87, 0x19, 0xf5, 0x19, 0xac, 0xa4, 0x0e, 0xb7, 0x37, 0x12, 0x06, 0xd2, 0x40, 0xa5,
-	0x3b, 0x67, 0x00, 0x45, 0x7c, 0xed, 0xc3, 0x7c, 0x62, 0xc4, 0x4d, 0x66, 0x11, 0x57, 0x4f, 0x64,
-	0x71, 0xcc, 0x74, 0x9b, 0x51, 0xbf, 0x53, 0xe0, 0xea, 0x89, 0xc3, 0x6d, 0x32, 0xd2, 0x93, 0xc0,
-	0xa5, 0x7b, 0xe7, 0x00, 0x47, 0x82, 0xb0, 0x61, 0x39, 0x6d, 0x4c, 0xa9, 0x9e, 0x68, 0x8d, 0x63,
-	0x4a, 0x1f, 0x9f, 0x8e, 0x89, 0x38, 0x7a, 0x02, 0x0b, 0x2d, 0x64, 0xb1, 0xc1, 0xe3, 0x4a, 0xc2,
-	0x40, 0x54, 0x59, 0xba, 0x71, 0x82, 0x32, 0x46, 0x85, 0x62, 0xdc, 0x6f, 0xa4, 0x35, 0x5f, 0x4f,
-	0x98, 0x98, 0x84, 0x94, 0x6e, 0x9f, 0x0a, 0x39, 0xf2, 0x55, 0x7f, 0xf2, 0xe6, 0x43, 0x59, 0x79,
-	0xf7, 0xa1, 0xac, 0xfc, 0xf5, 0xa1, 0xac, 0xfc, 0x78, 0x58, 0xce, 0xbc, 0x3b, 0x2c, 0x67, 0xfe,
-	0x38, 0x2c, 0x67, 0xbe, 0xf9, 0x2c, 0xf2, 0xe6, 0xf6, 0xd1, 0xb6, 0x47, 0xcf, 0x86, 0xe1, 0x9f,
-	0xdc, 0x9a, 0xf8, 0x0f, 0xa7, 0xbb, 0xc4, 0x1a, 0xf4, 0x50, 0x1f, 0xde, 0xd3, 0x0f, 0x42, 0x95,
-	0xe8, 0xa2, 0x9d, 0x69, 0x3e, 0xfb, 0xdd, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x17, 0x13, 0x74,
-	0x8d, 0x80, 0x0f, 0x00, 0x00,
+	// 1566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6b, 0x1b, 0x47,
+	0x1b, 0xf7, 0x4a, 0xb2, 0x1d, 0x3f, 0xfe, 0x88, 0xbd, 0x76, 0x62, 0x79, 0x63, 0x4b, 0xf6, 0x1a,
+	0xbf, 0xb1, 0x5f, 0xbf, 0x96, 0x62, 0x27, 0xf0, 0x96, 0x94, 0x7e, 0x58, 0x76, 0x42, 0x4a, 0x71,
+	0x0e, 0x2b, 0x27, 0x84, 0x42, 0x11, 0xab, 0xdd, 0xc9, 0x6a, 0x13, 0xed, 0x8e, 0xba, 0x33, 0x12,
+	0xd6, 0xb5, 0x50, 0x28, 0x3d, 0xb5, 0x87, 0x42, 0x8f, 0x39, 0x94, 0x9e, 0x7b, 0xc8, 0x3f, 0x90,
+	0x53, 0xd3, 0x9c, 0x02, 0xbd, 0x94, 0x1e, 0x42, 0x49, 0xa0, 0xf4, 0x6f, 0x28, 0x14, 0xca, 0xce,
+	0xcc, 0xae, 0x77, 0x57, 0x6b, 0x49, 0x2e, 0xbd, 0xf4, 0xa4, 0x9d, 0xe7, 0xf9, 0xcd, 0xf3, 0x35,
+	0xbf, 0x99, 0x79, 0x46, 0x70, 0xc9, 0xf2, 0xf4, 0x8e, 0x4d, 0xbb, 0xe5, 0xce, 0x6e, 0xd9, 0x21,
+	0x16, 0x29, 0xb5, 0x3c, 0x4c, 0xb1, 0x0c, 0x42, 0x5c, 0xea, 0xec, 0x2a, 0x05, 0x03, 0x13, 0x07,
+	0x93, 0x72, 0x5d, 0x27, 0xa8, 0xdc, 0xd9, 0xad, 0x23, 0xaa, 0xef, 0x96, 0x0d, 0x6c, 0xbb, 0x1c,
+	0xab, 0x2c, 0x71, 0x7d, 0x8d, 0x8d, 0xca, 0x7c, 0x20, 0x54, 0xf9, 0x88, 0xf5, 0xc0, 0x22, 0xd7,
+	0x2c, 0x58, 0xd8, 0xc2, 0x7c, 0x86, 0xff, 0x25, 0xa4, 0xcb, 0x16, 0xc6, 0x56, 0x13, 0x95, 0xf5,
+	0x96, 0x5d, 0xd6, 0x5d, 0x17, 0x53, 0x9d, 0xda, 0xd8, 0x0d, 0xac, 0x2d, 0x09, 0x2d, 0x1b, 0xd5,
+	0xdb, 0x0f, 0xcb, 0xba, 0x2b, 0xcc, 0xa9, 0x3f, 0x49, 0x30, 0x77, 0x44, 0xac, 0x2a, 0x72, 0xcd,
+	0x63, 0x7c, 0x8b, 0x36, 0x90, 0x87, 0xda, 0x8e, 0x7c, 0x19, 0xc6, 0x08, 0x72, 0x4d, 0xe4, 0xe5,
+	0xa5, 0x55, 0x69, 0x73, 0x42, 0x13, 0x23, 0x79, 0x07, 0x64, 0x24, 0x30, 0x35, 0x0f, 0x19, 0x76,
+	0xcb, 0x46, 0x2e, 0xcd, 0x67, 0x18, 0x66, 0x2e, 0xd0, 0x68, 0x81, 0x42, 0xfe, 0x3f, 0x8c, 0xe9,
+	0x0e, 0x6e, 0xbb, 0x34, 0x9f, 0x5d, 0x95, 0x36, 0x27, 0xf7, 0x96, 0x4a, 0x22, 0x49, 0xbf, 0x22,
+	0x25, 0x51, 0x91, 0xd2, 0x01, 0xb6, 0xdd, 0x4a, 0xee, 0xf9, 0xab, 0xe2, 0x88, 0x26, 0xe0, 0xf2,
+	0xbb, 0x00, 0x75, 0xcf, 0x36, 0x2d, 0x54, 0x7b, 0x88, 0x50, 0x3e, 0x37, 0xdc, 0xe4, 0x09, 0x3e,
+	0xe5, 0x36, 0x42, 0xea, 0x36, 0x2c, 0xf5, 0x24, 0xa5, 0x21, 0xd2, 0xc2, 0x2e, 0x41, 0xf2, 0x0c,
+	0x64, 0x6c, 0x93, 0x25, 0x96, 0xd3, 0x32, 0xb6, 0xa9, 0xee, 0xc3, 0xe2, 0x11, 0xb1, 0x0e, 0x74,
+	0xd7, 0x40, 0xcd, 0x44, 0x1d, 0x12, 0xd0, 0x48, 0x5d, 0x32, 0xd1, 0xba, 0xa8, 0x6b, 0x50, 0x3c,
+	0xc3, 0x44, 0xe0, 0x55, 0xdd, 0x67, 0x75, 0xd6, 0xd0, 0x27, 0x6d, 0x44, 0x68, 0x45, 0xa7, 0x46,
+	0xe3, 0xf8, 0x44, 0x5e, 0x80, 0x51, 0x13, 0xb9, 0xd8, 0x11, 0x65, 0xe6, 0x03, 0xe6, 0xc5, 0xb6,
+	0xdc, 0x88, 0x17, 0x36, 0x52, 0xaf, 0xc0, 0x52, 0x8f, 0x89, 0xd0, 0xfe, 0xd7, 0x12, 0x8b, 0xa1,
+	0xda, 0xae, 0x3b, 0x36, 0x0d, 0xbc, 0x1f, 0x9f, 0x1c, 0x60, 0xf7, 0xa1, 0xed, 0x39, 0x8c, 0x0e,
+	0xf2, 0x31, 0x4c, 0x19, 0x91, 0x31, 0xf3, 0x3a, 0xb9, 0xb7, 0x50, 0xe2, 0xf4, 0x28, 0x05, 0xf4,
+	0x28, 0xed, 0xbb, 0xdd, 0x8a, 0xf2, 0xe2, 0xe9, 0xce, 0xe5, 0x74, 0x3b, 0x5a, 0xcc, 0xca, 0x59,
+	0xe1, 0xde, 0xcc, 0x7d, 0xfe, 0xa4, 0x38, 0xa2, 0x3e, 0x93, 0x40, 0x39, 0xc0, 0x2e, 0xf5, 0x74,
+	0x83, 0x1e, 0xe8, 0xcd, 0x66, 0x22, 0xa4, 0x1d, 0x90, 0x6d, 0xb7, 0xa3, 0x37, 0x6d, 0x93, 0x8d,
+	0x6b, 0xc4, 0xc0, 0x2d, 0xc4, 0x02, 0x9b, 0xd2, 0xe6, 0xa2, 0x9a, 0xaa, 0xaf, 0xe8, 0x81, 0xbb,
+	0xd8, 0x35, 0x10, 0xf3, 0x9b, 0x8b, 0xc3, 0xef, 0xfa, 0x0a, 0xf9, 0x2a, 0x5c, 0x0c, 0xf9, 0x2a,
+	0x62, 0xcc, 0xb2, 0x18, 0x67, 0x02, 0x71, 0x95, 0x49, 0xe5, 0x65, 0x98, 0xf0, 0xf5, 0x3a, 0x6d,
+	0x7b, 0x9c, 0x6f, 0x53, 0xda, 0xa9, 0x40, 0xfd, 0x56, 0x82, 0x79, 0x51, 0xef, 0x58, 0xf0, 0x1b,
+	0x30, 0x43, 0xf1, 0x63, 0xe4, 0xd6, 0x0c, 0x91, 0xa0, 0x58, 0xc7, 0x69, 0x26, 0x0d, 0xb2, 0x96,
+	0x8b, 0x30, 0x59, 0xf7, 0x67, 0xc7, 0xa2, 0x05, 0x26, 0xfa, 0x47, 0xc3, 0xfc, 0x42, 0x82, 0x45,
+	0x0e, 0xac, 0x22, 0x9a, 0x08, 0x75, 0x13, 0x66, 0xb9, 0xe5, 0x1a, 0x41, 0x54, 0x04, 0xc2, 0x79,
+	0x3d, 0x43, 0x82, 0x29, 0x67, 0x06, 0x93, 0x19, 0x1c, 0x4c, 0x36, 0x19, 0xcc, 0x16, 0x5c, 0x1d,
+	0x40, 0xc7, 0x90, 0xba, 0xdf, 0x48, 0xb0, 0x3a, 0x00, 0x4b, 0xe4, 0xfb, 0x30, 0x1d, 0x65, 0x1d,
+	0xc9, 0x4b, 0xab, 0xd9, 0xbf, 0x45, 0xde, 0xb8, 0x99, 0x01, 0xec, 0xbd, 0x0b, 0xcb, 0x67, 0x06,
+	0xdf, 0x6e, 0x52, 0x39, 0x0f, 0xe3, 0xa4, 0x6d, 0x18, 0x88, 0x10, 0x56, 0xcd, 0x0b, 0x5a, 0x30,
+	0xf4, 0xb7, 0x36, 0xf2, 0x3c, 0x1c, 0x98, 0xe5, 0x03, 0xd5, 0x85, 0xcd, 0x41, 0x99, 0x86, 0xe7,
+	0x54, 0x05, 0xc6, 0x3d, 0xe6, 0x25, 0xc8, 0x75, 0xb3, 0x74, 0x7a, 0xb9, 0x94, 0xfa, 0x85, 0xa5,
+	0x05, 0x13, 0xd5, 0x36, 0x5c, 0xee, 0xf1, 0x77, 0xab, 0xe3, 0x9f, 0xcd, 0xef, 0xc0, 0x28, 0xf2,
+	0x3f, 0xfa, 0x1e, 0x02, 0x73, 0x2f, 0x9e, 0xee, 0x4c, 0xc7, 0xe6, 0x69, 0x7c, 0xd6, 0x80, 0xb2,
+	0xad, 0x42, 0x21, 0xdd, 0x6d, 0xb8, 0xe6, 0x27, 0xb0, 0x98, 0x8e, 0x20, 0xf2, 0x7b, 0x30, 0xc6,
+	0x7c, 0xf4, 0x5f, 0xe2, 0x94, 0xd0, 0xc4, 0xb4, 0x01, 0xb1, 0xad, 0xa5, 0x9c, 0x93, 0xdc, 0x73,
+	0x18, 0xdc, 0x33, 0x09, 0x2e, 0x1e, 0x11, 0xeb, 0x10, 0x35, 0x91, 0xa5, 0x53, 0xf4, 0x21, 0xea,
+	0x12, 0x79, 0x1b, 0xe6, 0xc4, 0xe9, 0x82, 0xbd, 0x9a, 0x6e, 0x9a, 0x5e, 0xb0, 0xe6, 0x13, 0xda,
+	0x6c, 0xa8, 0xd8, 0xe7, 0x72, 0x79, 0x17, 0x16, 0xb0, 0x67, 0x34, 0x10, 0xa1, 0x5e, 0x0c, 0xcf,
+	0xe3, 0x99, 0x8f, 0xea, 0x82, 0x29, 0x5b, 0x30, 0x1b, 0x6e, 0xbb, 0x00, 0xce, 0x0f, 0x81, 0x70,
+	0x3b, 0x06, 0xd0, 0x75, 0x98, 0x46, 0xb4, 0x51, 0x4b, 0x9e, 0x04, 0x53, 0x88, 0x36, 0xaa, 0xe1,
+	0xfe, 0x5b, 0x82, 0xc5, 0x44, 0x0a, 0x61, 0x7a, 0x0f, 0x60, 0x3e, 0x2a, 0xf7, 0xe7, 0x1c, 0x11,
+	0xeb, 0x7c, 0x19, 0x2e, 0xc0, 0x68, 0xf4, 0x34, 0xe3, 0x03, 0xf5, 0x07, 0x09, 0x2e, 0xf9, 0x57,
+	0x14, 0xa6, 0x3a, 0x45, 0xff, 0xea, 0xf2, 0x15, 0x61, 0x25, 0x35, 0x91, 0x48, 0x11, 0xfd, 0x4c,
+	0x03, 0x02, 0xdd, 0x41, 0xb6, 0xd5, 0xa0, 0xf7, 0x31, 0x8d, 0x9f, 0x9f, 0x0d, 0x26, 0x0e, 0x0e,
+	0x5a, 0x14, 0x03, 0x9f, 0x79, 0xcd, 0x73, 0xd7, 0xbd, 0x96, 0x43, 0xd7, 0xbf, 0x65, 0x60, 0x8e,
+	0x77, 0x19, 0x07, 0xac, 0x23, 0xe2, 0x1b, 0xba, 0x08, 0x93, 0x8c, 0xff, 0xb1, 0xc3, 0x1d, 0x98,
+	0x88, 0x1f, 0xec, 0xbd, 0xb7, 0x55, 0x26, 0xed, 0xb6, 0xba, 0x1d, 0x6b, 0xda, 0x26, 0x2a, 0x25,
+	0xbf, 0xb9, 0xfa, 0xe5, 0x55, 0xf1, 0x3f, 0x96, 0x4d, 0x1b, 0xed, 0x7a, 0xc9, 0xc0, 0x8e, 0xe8,
+	0x55, 0xc5, 0xcf, 0x0e, 0x31, 0x1f, 0x97, 0x69, 0xb7, 0x85, 0x48, 0xe9, 0x03, 0x7f, 0x17, 0xf2,
+	0xd9, 0xf1, 0x7b, 0x84, 0x37, 0x4d, 0xb9, 0xc4, 0x3d, 0xc2, 0xa4, 0x3e, 0x50, 0x34, 0xc2, 0x1e,
+	0x32, 0x90, 0xdd, 0x41, 0x5e, 0x7e, 0x94, 0x03, 0xb9, 0x58, 0x13, 0xd2, 0xb4, 0xca, 0x8e, 0xa5,
+	0x56, 0xf6, 0x7d, 0x98, 0x41, 0x9e, 0xb1, 0x77, 0xad, 0xe6, 0x20, 0xaa, 0x9b, 0x3a, 0xd5, 0xf3,
+	0xe3, 0xa2, 0x85, 0x8c, 0x1e, 0xa0, 0xda, 0xc1, 0xde, 0xb5, 0x23, 0x01, 0xd0, 0xa6, 0xd9, 0x84,
+	0x60, 0x78, 0x33, 0xf7, 0xfb, 0x93, 0xa2, 0xa4, 0x7e, 0x0c, 0xd3, 0x31, 0x94, 0x2c, 0x43, 0xce,
+	0xd5, 0x1d, 0x24, 0x88, 0xcb, 0xbe, 0xd9, 0x32, 0x76, 0x9d, 0x3a, 0x6e, 0x86, 0xcb, 0xc8, 0x46,
+	0xb2, 0x02, 0x17, 0x4c, 0x64, 0xd8, 0x8e, 0xde, 0xe4, 0x4c, 0xcc, 0x69, 0xe1, 0x58, 0x98, 0xff,
+	0x4e, 0x02, 0x99, 0xb5, 0x15, 0xb7, 0x4e, 0x90, 0xd1, 0xa6, 0xc8, 0xe4, 0x0b, 0x39, 0x7c, 0x57,
+	0x11, 0x5d, 0xef, 0x4c, 0xcf, 0x7a, 0xa7, 0x94, 0x2b, 0x9b, 0x5a, 0xae, 0x44, 0x7f, 0x92, 0x4b,
+	0xf6, 0x27, 0xea, 0x9f, 0x12, 0x2c, 0x45, 0x7b, 0xb8, 0x78, 0xbc, 0x03, 0x89, 0x67, 0xa5, 0xf6,
+	0x78, 0x7e, 0xc0, 0x53, 0x95, 0xb7, 0xfe, 0x78, 0x55, 0xbc, 0x11, 0x61, 0x16, 0x65, 0x9c, 0x70,
+	0x6c, 0x97, 0x46, 0x3f, 0x9b, 0x76, 0x9d, 0x94, 0xeb, 0x5d, 0x8a, 0x48, 0xe9, 0x0e, 0x3a, 0xa9,
+	0xf8, 0x1f, 0xc3, 0x77, 0x87, 0xd9, 0x61, 0xba, 0x43, 0x51, 0xa0, 0x5c, 0x5a, 0x81, 0xd4, 0xaf,
+	0x32, 0x20, 0x33, 0x22, 0x1c, 0xa2, 0x56, 0x13, 0x77, 0x87, 0x4e, 0x7c, 0x0d, 0xa6, 0x38, 0x85,
+	0x6b, 0xbc, 0xcb, 0xe7, 0x04, 0x99, 0xe4, 0xb2, 0x43, 0x5f, 0x94, 0xb2, 0xd8, 0xd9, 0xb4, 0xc5,
+	0x5e, 0x01, 0xe0, 0x8c, 0x66, 0xf4, 0xe3, 0xfb, 0x68, 0x82, 0x49, 0xee, 0xfa, 0x1c, 0x5c, 0x83,
+	0x29, 0xae, 0x16, 0x4c, 0xe4, 0xfb, 0x67, 0x92, 0xc9, 0xaa, 0x9c, 0x8e, 0x1b, 0xc1, 0x9e, 0x08,
+	0x49, 0xc9, 0xf7, 0x0e, 0x27, 0xfe, 0xa1, 0x10, 0xa6, 0xd5, 0x64, 0x3c, 0xb5, 0x26, 0x3f, 0x4a,
+	0x90, 0x8f, 0x34, 0x9b, 0xe7, 0xa4, 0xc4, 0x0e, 0xcc, 0x47, 0xda, 0x51, 0x7a, 0x12, 0x23, 0xf1,
+	0x2c, 0x39, 0xb5, 0x7b, 0x4e, 0x2a, 0xdf, 0x80, 0x71, 0x07, 0x39, 0x75, 0xe4, 0x91, 0x7c, 0x8e,
+	0x35, 0x0f, 0x4a, 0x5a, 0xcf, 0xc4, 0xe3, 0xd6, 0x02, 0xe8, 0xde, 0xf7, 0x17, 0x20, 0xeb, 0xdf,
+	0x80, 0x0f, 0x60, 0x26, 0xf1, 0x00, 0x5c, 0x89, 0x4e, 0xef, 0x79, 0x52, 0x2a, 0x1b, 0x7d, 0xd5,
+	0xe1, 0x81, 0x3d, 0x22, 0x3f, 0x82, 0x85, 0xd4, 0x07, 0xe6, 0x7a, 0xc2, 0x40, 0x1a, 0x48, 0xd9,
+	0x1e, 0x02, 0x14, 0xf1, 0xf5, 0x00, 0x66, 0x12, 0xcf, 0xcc, 0x64, 0x16, 0x71, 0xb5, 0xb2, 0xd1,
+	0x57, 0x1d, 0xb1, 0xfc, 0xa9, 0x04, 0xcb, 0x7d, 0x1f, 0x98, 0xc9, 0x48, 0xfb, 0x81, 0x95, 0xeb,
+	0xe7, 0x00, 0x47, 0x82, 0xb0, 0x60, 0x3e, 0xad, 0x9f, 0x55, 0xfb, 0x5a, 0x63, 0x18, 0xe5, 0xbf,
+	0x83, 0x31, 0xf1, 0x35, 0x4b, 0xed, 0x4f, 0xd7, 0x07, 0x5b, 0x21, 0xca, 0xf6, 0x10, 0xa0, 0x88,
+	0xaf, 0x7b, 0x70, 0xb1, 0x8a, 0x68, 0xac, 0x63, 0xba, 0x92, 0xb0, 0x10, 0x55, 0x2a, 0xeb, 0x7d,
+	0x94, 0xb1, 0x14, 0xf2, 0x71, 0xc7, 0x91, 0x3e, 0x65, 0x2d, 0x61, 0xa2, 0x17, 0xa2, 0x6c, 0x0d,
+	0x84, 0x44, 0x7c, 0x7d, 0x26, 0xc1, 0x4a, 0xff, 0x27, 0xdc, 0xff, 0xce, 0xb1, 0xe0, 0x44, 0xb9,
+	0x71, 0x1e, 0x74, 0x24, 0x0e, 0x13, 0xe4, 0x94, 0xfe, 0x33, 0x99, 0x6d, 0x2f, 0x44, 0xd9, 0x1a,
+	0x08, 0x39, 0xf5, 0x52, 0xb9, 0xf7, 0xfc, 0x75, 0x41, 0x7a, 0xf9, 0xba, 0x20, 0xfd, 0xfa, 0xba,
+	0x20, 0x7d, 0xf9, 0xa6, 0x30, 0xf2, 0xf2, 0x4d, 0x61, 0xe4, 0xe7, 0x37, 0x85, 0x91, 0x8f, 0xde,
+	0x8e, 0xdc, 0x66, 0x2d, 0x64, 0x59, 0xdd, 0x47, 0x9d, 0xe0, 0x2f, 0xbc, 0x1d, 0xfe, 0x0f, 0x55,
+	0xd9, 0xc1, 0x66, 0xbb, 0x89, 0xca, 0x9d, 0xeb, 0xe5, 0x93, 0x40, 0xc5, 0x1b, 0xa8, 0xfa, 0x18,
+	0x7b, 0xe3, 0x5c, 0xff, 0x6b, 0x00, 0x94, 0xbb, 0xda, 0x7a, 0x5e, 0x14, 0x00, 0x00,
 }
 
 func (this *SendToCosmosEvent) Equal(that interface{}) bool {
@@ -1477,9 +1902,42 @@ func (this *SendToCosmosEvent) Equal(that interface{}) bool {
 	if this.EthereumHeight != that1.EthereumHeight {
 		return false
 	}
+	if !this.Erc20Metadata.Equal(that1.Erc20Metadata) {
+		return false
+	}
 	return true
 }
-
+func (this *ERC20Metadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ERC20Metadata)
+	if !ok {
+		that2, ok := that.(ERC20Metadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
+	return true
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -1497,8 +1955,11 @@ type MsgClient interface {
 	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
 	SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error)
 	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
+	SubmitEthereumEvents(ctx context.Context, in *MsgSubmitEthereumEvents, opts ...grpc.CallOption) (*MsgSubmitEthereumEventsResponse, error)
 	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
 	SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereumHeightVote, opts ...grpc.CallOption) (*MsgEthereumHeightVoteResponse, error)
+	SubmitEthereumTxConfirmations(ctx context.Context, in *MsgSubmitEthereumTxConfirmations, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationsResponse, error)
+	RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error)
 }
 
 type msgClient struct {
@@ -1554,6 +2015,15 @@ func (c *msgClient) SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthere
 	return out, nil
 }
 
+func (c *msgClient) SubmitEthereumEvents(ctx context.Context, in *MsgSubmitEthereumEvents, opts ...grpc.CallOption) (*MsgSubmitEthereumEventsResponse, error) {
+	out := new(MsgSubmitEthereumEventsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error) {
 	out := new(MsgDelegateKeysResponse)
 	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SetDelegateKeys", in, out, opts...)
@@ -1572,6 +2042,24 @@ func (c *msgClient) SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereu
 	return out, nil
 }
 
+func (c *msgClient) SubmitEthereumTxConfirmations(ctx context.Context, in *MsgSubmitEthereumTxConfirmations, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationsResponse, error) {
+	out := new(MsgSubmitEthereumTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error) {
+	out := new(MsgRotateDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RotateDelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
@@ -1579,8 +2067,11 @@ type MsgServer interface {
 	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
 	SubmitEthereumTxConfirmation(context.Context, *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error)
 	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
+	SubmitEthereumEvents(context.Context, *MsgSubmitEthereumEvents) (*MsgSubmitEthereumEventsResponse, error)
 	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
 	SubmitEthereumHeightVote(context.Context, *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error)
+	SubmitEthereumTxConfirmations(context.Context, *MsgSubmitEthereumTxConfirmations) (*MsgSubmitEthereumTxConfirmationsResponse, error)
+	RotateDelegateKeys(context.Context, *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1602,12 +2093,21 @@ func (*UnimplementedMsgServer) SubmitEthereumTxConfirmation(ctx context.Context,
 func (*UnimplementedMsgServer) SubmitEthereumEvent(ctx context.Context, req *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumEvent not implemented")
 }
+func (*UnimplementedMsgServer) SubmitEthereumEvents(ctx context.Context, req *MsgSubmitEthereumEvents) (*MsgSubmitEthereumEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumEvents not implemented")
+}
 func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
 }
 func (*UnimplementedMsgServer) SubmitEthereumHeightVote(ctx context.Context, req *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumHeightVote not implemented")
 }
+func (*UnimplementedMsgServer) SubmitEthereumTxConfirmations(ctx context.Context, req *MsgSubmitEthereumTxConfirmations) (*MsgSubmitEthereumTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumTxConfirmations not implemented")
+}
+func (*UnimplementedMsgServer) RotateDelegateKeys(ctx context.Context, req *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDelegateKeys not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1703,6 +2203,24 @@ func _Msg_SubmitEthereumEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitEthereumEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumEvents)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumEvents(ctx, req.(*MsgSubmitEthereumEvents))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDelegateKeys)
 	if err := dec(in); err != nil {
@@ -1739,6 +2257,42 @@ func _Msg_SubmitEthereumHeightVote_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitEthereumTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumTxConfirmations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumTxConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumTxConfirmations(ctx, req.(*MsgSubmitEthereumTxConfirmations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RotateDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, req.(*MsgRotateDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1763,6 +2317,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEthereumEvent",
 			Handler:    _Msg_SubmitEthereumEvent_Handler,
 		},
+		{
+			MethodName: "SubmitEthereumEvents",
+			Handler:    _Msg_SubmitEthereumEvents_Handler,
+		},
 		{
 			MethodName: "SetDelegateKeys",
 			Handler:    _Msg_SetDelegateKeys_Handler,
@@ -1771,6 +2329,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEthereumHeightVote",
 			Handler:    _Msg_SubmitEthereumHeightVote_Handler,
 		},
+		{
+			MethodName: "SubmitEthereumTxConfirmations",
+			Handler:    _Msg_SubmitEthereumTxConfirmations_Handler,
+		},
+		{
+			MethodName: "RotateDelegateKeys",
+			Handler:    _Msg_RotateDelegateKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -2184,6 +2750,127 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitEthereumTxConfirmations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumTxConfirmationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumTxConfirmationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumTxConfirmationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitEthereumEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2249,6 +2936,73 @@ func (m *MsgSubmitEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitEthereumEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthereumEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2358,7 +3112,7 @@ func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEthereumHeightVote) Marshal() (dAtA []byte, err error) {
+func (m *MsgRotateDelegateKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2368,22 +3122,96 @@ func (m *MsgEthereumHeightVote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumHeightVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.EthereumHeight != 0 {
 		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
@@ -2436,6 +3264,18 @@ func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Erc20Metadata != nil {
+		{
+			size, err := m.Erc20Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.EthereumHeight != 0 {
 		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
 		i--
@@ -2480,6 +3320,48 @@ func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ERC20Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2877,6 +3759,56 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitEthereumTxConfirmations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumTxConfirmationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgSubmitEthereumEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2903,6 +3835,34 @@ func (m *MsgSubmitEthereumEventResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitEthereumEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegateKeys) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2953,6 +3913,40 @@ func (m *DelegateKeysSignMsg) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateDelegateKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateDelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgEthereumHeightVote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3004,6 +3998,30 @@ func (m *SendToCosmosEvent) Size() (n int) {
 	if m.EthereumHeight != 0 {
 		n += 1 + sovMsgs(uint64(m.EthereumHeight))
 	}
+	if m.Erc20Metadata != nil {
+		l = m.Erc20Metadata.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovMsgs(uint64(m.Decimals))
+	}
 	return n
 }
 
@@ -4318,7 +5336,7 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthereumTxConfirmations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4341,15 +5359,15 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4376,10 +5394,8 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types1.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Confirmations = append(m.Confirmations, &types1.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4436,7 +5452,7 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
+func (m *EthereumTxConfirmationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4459,22 +5475,672 @@ func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthereumTxConfirmationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthereumTxConfirmationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &EthereumTxConfirmationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types1.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &types1.Any{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -4486,7 +6152,7 @@ func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4509,10 +6175,60 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4548,8 +6264,77 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateDelegateKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4577,11 +6362,11 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4609,13 +6394,13 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4625,76 +6410,58 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.EthSignature == nil {
-				m.EthSignature = []byte{}
-			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4716,7 +6483,7 @@ func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4739,63 +6506,12 @@ func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5165,6 +6881,175 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Erc20Metadata == nil {
+				m.Erc20Metadata = &ERC20Metadata{}
+			}
+			if err := m.Erc20Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
@@ -78,3 +79,30 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 	}
 
 }
+
+func TestValidateMsgSubmitEthereumTxConfirmations(t *testing.T) {
+	signer := sdk.AccAddress(bytes.Repeat([]byte{0x1}, app.MaxAddrLen))
+	confirmations := func(n int) []*cdctypes.Any {
+		anys := make([]*cdctypes.Any, n)
+		for i := range anys {
+			confirmation, err := types.PackConfirmation(&types.BatchTxConfirmation{
+				TokenContract:  "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+				BatchNonce:     uint64(i + 1),
+				EthereumSigner: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+				Signature:      []byte{1},
+			})
+			assert.NoError(t, err)
+			anys[i] = confirmation
+		}
+		return anys
+	}
+
+	msg := types.MsgSubmitEthereumTxConfirmations{Signer: signer.String()}
+	assert.Error(t, msg.ValidateBasic(), "no confirmations")
+
+	msg.Confirmations = confirmations(types.MaxEthereumTxConfirmations)
+	assert.NoError(t, msg.ValidateBasic())
+
+	msg.Confirmations = confirmations(types.MaxEthereumTxConfirmations + 1)
+	assert.Error(t, msg.ValidateBasic())
+}
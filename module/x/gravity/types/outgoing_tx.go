@@ -16,6 +16,10 @@ var (
 	_ OutgoingTx = &ContractCallTx{}
 )
 
+// CommunityPoolContractCallScope is the invalidation scope of contract calls
+// paid for by the community pool
+var CommunityPoolContractCallScope = []byte("gravity/community_pool")
+
 const (
 	_ = iota
 	SignerSetTxPrefixByte
@@ -31,6 +35,24 @@ type ABIEncodedValsetArgs struct {
 	RewardToken  gethcommon.Address   `abi:"rewardToken"`
 }
 
+type ABIEncodedValSignature struct {
+	V uint8    `abi:"v"`
+	R [32]byte `abi:"r"`
+	S [32]byte `abi:"s"`
+}
+
+type ABIEncodedLogicCallArgs struct {
+	TransferAmounts        []*big.Int           `abi:"transferAmounts"`
+	TransferTokenContracts []gethcommon.Address `abi:"transferTokenContracts"`
+	FeeAmounts             []*big.Int           `abi:"feeAmounts"`
+	FeeTokenContracts      []gethcommon.Address `abi:"feeTokenContracts"`
+	LogicContractAddress   gethcommon.Address   `abi:"logicContractAddress"`
+	Payload                []byte               `abi:"payload"`
+	TimeOut                *big.Int             `abi:"timeOut"`
+	InvalidationId         [32]byte             `abi:"invalidationId"`
+	InvalidationNonce      *big.Int             `abi:"invalidationNonce"`
+}
+
 ///////////////////
 // GetStoreIndex //
 ///////////////////
@@ -207,6 +229,115 @@ func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
 	return packCall(OutgoingLogicCallABIJSON, "checkpoint", args)
 }
 
+//////////////////////
+// GetRelayCalldata //
+//////////////////////
+
+// NewABIEncodedValSignature splits a stored Ethereum signature into the v, r
+// and s values the Gravity contract expects
+func NewABIEncodedValSignature(signature []byte) (out ABIEncodedValSignature, err error) {
+	if len(signature) != 65 {
+		return out, sdkerrors.Wrapf(ErrInvalid, "signature length %d", len(signature))
+	}
+	copy(out.R[:], signature[:32])
+	copy(out.S[:], signature[32:64])
+	out.V = signature[64]
+	// the contract uses ecrecover, which expects v to be 27 or 28
+	if out.V < 27 {
+		out.V += 27
+	}
+	return out, nil
+}
+
+// valsetArgs returns the signer set as the contract's ValsetArgs, in the
+// sorted order its checkpoint is computed over
+func (sstx *SignerSetTx) valsetArgs() ABIEncodedValsetArgs {
+	signers := append(EthereumSigners{}, sstx.Signers...)
+	signers.Sort()
+
+	args := ABIEncodedValsetArgs{
+		Validators:   make([]gethcommon.Address, len(signers)),
+		Powers:       make([]*big.Int, len(signers)),
+		Nonce:        new(big.Int).SetUint64(sstx.Nonce),
+		RewardAmount: big.NewInt(0),
+	}
+	for i, signer := range signers {
+		args.Validators[i] = gethcommon.HexToAddress(signer.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(signer.Power)
+	}
+	return args
+}
+
+// GetRelayCalldata returns the calldata of the updateValset call that moves
+// the Gravity contract from the current signer set to this one. The
+// signatures must be ordered against the sorted members of the current set.
+func (sstx *SignerSetTx) GetRelayCalldata(current *SignerSetTx, sigs []ABIEncodedValSignature) ([]byte, error) {
+	if sstx.Nonce <= current.Nonce {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "signer set nonce %d is not after current nonce %d", sstx.Nonce, current.Nonce)
+	}
+	return packRelayCall("updateValset", sstx.valsetArgs(), current.valsetArgs(), sigs)
+}
+
+// GetRelayCalldata returns the calldata of the submitBatch call that executes
+// the batch. The signatures must be ordered against the sorted members of the
+// current set.
+func (btx *BatchTx) GetRelayCalldata(current *SignerSetTx, sigs []ABIEncodedValSignature) ([]byte, error) {
+	amounts := make([]*big.Int, len(btx.Transactions))
+	destinations := make([]gethcommon.Address, len(btx.Transactions))
+	fees := make([]*big.Int, len(btx.Transactions))
+	for i, tx := range btx.Transactions {
+		amounts[i] = tx.Erc20Token.Amount.BigInt()
+		destinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient)
+		fees[i] = tx.Erc20Fee.Amount.BigInt()
+	}
+
+	return packRelayCall("submitBatch",
+		current.valsetArgs(),
+		sigs,
+		amounts,
+		destinations,
+		fees,
+		new(big.Int).SetUint64(btx.BatchNonce),
+		gethcommon.HexToAddress(btx.TokenContract),
+		new(big.Int).SetUint64(btx.Timeout),
+	)
+}
+
+// GetRelayCalldata returns the calldata of the submitLogicCall call that
+// executes the contract call. The signatures must be ordered against the
+// sorted members of the current set.
+func (cctx *ContractCallTx) GetRelayCalldata(current *SignerSetTx, sigs []ABIEncodedValSignature) ([]byte, error) {
+	args := ABIEncodedLogicCallArgs{
+		TransferAmounts:        make([]*big.Int, len(cctx.Tokens)),
+		TransferTokenContracts: make([]gethcommon.Address, len(cctx.Tokens)),
+		FeeAmounts:             make([]*big.Int, len(cctx.Fees)),
+		FeeTokenContracts:      make([]gethcommon.Address, len(cctx.Fees)),
+		LogicContractAddress:   gethcommon.HexToAddress(cctx.Address),
+		Payload:                cctx.Payload,
+		TimeOut:                new(big.Int).SetUint64(cctx.Timeout),
+		InvalidationNonce:      new(big.Int).SetUint64(cctx.InvalidationNonce),
+	}
+	for i, coin := range cctx.Tokens {
+		args.TransferAmounts[i] = coin.Amount.BigInt()
+		args.TransferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	for i, coin := range cctx.Fees {
+		args.FeeAmounts[i] = coin.Amount.BigInt()
+		args.FeeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	copy(args.InvalidationId[:], cctx.InvalidationScope)
+
+	return packRelayCall("submitLogicCall", current.valsetArgs(), sigs, args)
+}
+
+func packRelayCall(method string, args ...interface{}) ([]byte, error) {
+	gravityABI, err := abi.JSON(strings.NewReader(GravityABIJSON))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
+	}
+	return gravityABI.Pack(method, args...)
+}
+
 func packCall(abiString, method string, args []interface{}) []byte {
 	encodedCall, err := abi.JSON(strings.NewReader(abiString))
 	if err != nil {
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRelayCalldata(t *testing.T) {
+	gravityABI, err := abi.JSON(strings.NewReader(GravityABIJSON))
+	require.NoError(t, err)
+
+	unpack := func(method string, calldata []byte) []interface{} {
+		require.Equal(t, gravityABI.Methods[method].ID, calldata[:4])
+		args, err := gravityABI.Methods[method].Inputs.Unpack(calldata[4:])
+		require.NoError(t, err)
+		return args
+	}
+
+	var (
+		erc20Addr = gethcommon.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+		signerA   = gethcommon.HexToAddress("0xc783df8a850f42e7F7e57013759C285caa701eB6")
+		signerB   = gethcommon.HexToAddress("0xE5904695748fe4A84b40b3fc79De2277660BD1D3")
+	)
+
+	// the signer set is encoded in sorted order, highest power first
+	current := &SignerSetTx{
+		Nonce: 2,
+		Signers: EthereumSigners{
+			{EthereumAddress: signerA.Hex(), Power: 1_000},
+			{EthereumAddress: signerB.Hex(), Power: 2_000},
+		},
+	}
+	sig, err := NewABIEncodedValSignature(append(bytes.Repeat([]byte{1}, 64), 0))
+	require.NoError(t, err)
+	require.EqualValues(t, 27, sig.V)
+	sigs := []ABIEncodedValSignature{sig, {}}
+
+	_, err = NewABIEncodedValSignature([]byte{1})
+	require.Error(t, err)
+
+	// the contract's ValsetArgs as decoded by the ABI package
+	type valsetArgs = struct {
+		Validators   []gethcommon.Address `json:"validators"`
+		Powers       []*big.Int           `json:"powers"`
+		ValsetNonce  *big.Int             `json:"valsetNonce"`
+		RewardAmount *big.Int             `json:"rewardAmount"`
+		RewardToken  gethcommon.Address   `json:"rewardToken"`
+	}
+	requireValset := func(arg interface{}, nonce uint64, validators []gethcommon.Address, powers ...uint64) {
+		args := arg.(valsetArgs)
+		require.Equal(t, nonce, args.ValsetNonce.Uint64())
+		require.Equal(t, validators, args.Validators)
+		require.Len(t, args.Powers, len(powers))
+		for i, power := range powers {
+			require.Equal(t, power, args.Powers[i].Uint64())
+		}
+		require.Zero(t, args.RewardAmount.Sign())
+		require.Equal(t, gethcommon.Address{}, args.RewardToken)
+	}
+	requireCurrentValset := func(arg interface{}) {
+		requireValset(arg, 2, []gethcommon.Address{signerB, signerA}, 2_000, 1_000)
+	}
+	currentSigs := []struct {
+		V uint8    `json:"v"`
+		R [32]byte `json:"r"`
+		S [32]byte `json:"s"`
+	}{
+		{V: sig.V, R: sig.R, S: sig.S},
+		{},
+	}
+
+	t.Run("signer set", func(t *testing.T) {
+		next := &SignerSetTx{Nonce: 3, Signers: EthereumSigners{{EthereumAddress: signerA.Hex(), Power: 3_000}}}
+		calldata, err := next.GetRelayCalldata(current, sigs)
+		require.NoError(t, err)
+
+		args := unpack("updateValset", calldata)
+		requireValset(args[0], 3, []gethcommon.Address{signerA}, 3_000)
+		requireCurrentValset(args[1])
+		require.Equal(t, currentSigs, args[2])
+
+		_, err = current.GetRelayCalldata(current, sigs)
+		require.Error(t, err, "signer set nonce must be after the current one")
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		batch := &BatchTx{
+			BatchNonce: 7,
+			Timeout:    2111,
+			Transactions: []*SendToEthereum{
+				{
+					Id:                1,
+					EthereumRecipient: signerA.Hex(),
+					Erc20Token:        NewSDKIntERC20Token(sdk.NewInt(100), erc20Addr),
+					Erc20Fee:          NewSDKIntERC20Token(sdk.NewInt(5), erc20Addr),
+				},
+			},
+			TokenContract: erc20Addr.Hex(),
+		}
+		calldata, err := batch.GetRelayCalldata(current, sigs)
+		require.NoError(t, err)
+
+		args := unpack("submitBatch", calldata)
+		requireCurrentValset(args[0])
+		require.Equal(t, currentSigs, args[1])
+		require.Equal(t, []*big.Int{big.NewInt(100)}, args[2])
+		require.Equal(t, []gethcommon.Address{signerA}, args[3])
+		require.Equal(t, []*big.Int{big.NewInt(5)}, args[4])
+		require.Equal(t, big.NewInt(7), args[5])
+		require.Equal(t, erc20Addr, args[6])
+		require.Equal(t, big.NewInt(2111), args[7])
+	})
+
+	t.Run("contract call", func(t *testing.T) {
+		call := &ContractCallTx{
+			InvalidationScope: []byte("invalidationId"),
+			InvalidationNonce: 4,
+			Address:           signerB.Hex(),
+			Payload:           []byte("payload"),
+			Timeout:           5000,
+			Tokens:            []ERC20Token{NewSDKIntERC20Token(sdk.NewInt(10), erc20Addr)},
+			Fees:              []ERC20Token{NewSDKIntERC20Token(sdk.NewInt(1), erc20Addr)},
+		}
+		calldata, err := call.GetRelayCalldata(current, sigs)
+		require.NoError(t, err)
+
+		args := unpack("submitLogicCall", calldata)
+		requireCurrentValset(args[0])
+		require.Equal(t, currentSigs, args[1])
+
+		var invalidationId [32]byte
+		copy(invalidationId[:], "invalidationId")
+		require.Equal(t, struct {
+			TransferAmounts        []*big.Int           `json:"transferAmounts"`
+			TransferTokenContracts []gethcommon.Address `json:"transferTokenContracts"`
+			FeeAmounts             []*big.Int           `json:"feeAmounts"`
+			FeeTokenContracts      []gethcommon.Address `json:"feeTokenContracts"`
+			LogicContractAddress   gethcommon.Address   `json:"logicContractAddress"`
+			Payload                []byte               `json:"payload"`
+			TimeOut                *big.Int             `json:"timeOut"`
+			InvalidationId         [32]byte             `json:"invalidationId"`
+			InvalidationNonce      *big.Int             `json:"invalidationNonce"`
+		}{
+			TransferAmounts:        []*big.Int{big.NewInt(10)},
+			TransferTokenContracts: []gethcommon.Address{erc20Addr},
+			FeeAmounts:             []*big.Int{big.NewInt(1)},
+			FeeTokenContracts:      []gethcommon.Address{erc20Addr},
+			LogicContractAddress:   signerB,
+			Payload:                []byte("payload"),
+			TimeOut:                big.NewInt(5000),
+			InvalidationId:         invalidationId,
+			InvalidationNonce:      big.NewInt(4),
+		}, args[2])
+	})
+}
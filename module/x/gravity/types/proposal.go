@@ -1,10 +1,12 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -12,14 +14,46 @@ import (
 const (
 	// ProposalTypeCommunityPoolEthereumSpend defines the type for a CommunityPoolEthereumSpendProposal
 	ProposalTypeCommunityPoolEthereumSpend = "CommunityPoolEthereumSpend"
+	// ProposalTypeSignerSetTxCreation defines the type for a SignerSetTxCreationProposal
+	ProposalTypeSignerSetTxCreation = "SignerSetTxCreation"
+	// ProposalTypeRegisterCosmosDenom defines the type for a RegisterCosmosDenomProposal
+	ProposalTypeRegisterCosmosDenom = "RegisterCosmosDenom"
+	// ProposalTypeUpdateCosmosDenomERC20 defines the type for a UpdateCosmosDenomERC20Proposal
+	ProposalTypeUpdateCosmosDenomERC20 = "UpdateCosmosDenomERC20"
+	// ProposalTypeCommunityPoolEthereumContractCall defines the type for a CommunityPoolEthereumContractCallProposal
+	ProposalTypeCommunityPoolEthereumContractCall = "CommunityPoolEthereumContractCall"
+	// ProposalTypeCommunityPoolEthereumMultiSpend defines the type for a CommunityPoolEthereumMultiSpendProposal
+	ProposalTypeCommunityPoolEthereumMultiSpend = "CommunityPoolEthereumMultiSpend"
+	// ProposalTypeCommunityPoolContractCallRefund defines the type for a CommunityPoolContractCallRefundProposal
+	ProposalTypeCommunityPoolContractCallRefund = "CommunityPoolContractCallRefund"
 )
 
-// Assert CommunityPoolEthereumSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+	_ govtypes.Content = &SignerSetTxCreationProposal{}
+	_ govtypes.Content = &RegisterCosmosDenomProposal{}
+	_ govtypes.Content = &UpdateCosmosDenomERC20Proposal{}
+	_ govtypes.Content = &CommunityPoolEthereumContractCallProposal{}
+	_ govtypes.Content = &CommunityPoolEthereumMultiSpendProposal{}
+	_ govtypes.Content = &CommunityPoolContractCallRefundProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolEthereumSpendProposal{}, "gravity/CommunityPoolEthereumSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeSignerSetTxCreation)
+	govtypes.RegisterProposalTypeCodec(&SignerSetTxCreationProposal{}, "gravity/SignerSetTxCreationProposal")
+	govtypes.RegisterProposalType(ProposalTypeRegisterCosmosDenom)
+	govtypes.RegisterProposalTypeCodec(&RegisterCosmosDenomProposal{}, "gravity/RegisterCosmosDenomProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateCosmosDenomERC20)
+	govtypes.RegisterProposalTypeCodec(&UpdateCosmosDenomERC20Proposal{}, "gravity/UpdateCosmosDenomERC20Proposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumContractCall)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolEthereumContractCallProposal{}, "gravity/CommunityPoolEthereumContractCallProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumMultiSpend)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolEthereumMultiSpendProposal{}, "gravity/CommunityPoolEthereumMultiSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolContractCallRefund)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolContractCallRefundProposal{}, "gravity/CommunityPoolContractCallRefundProposal")
 }
 
 // NewCommunityPoolEthereumSpendProposal creates a new community pool spend proposal.
@@ -80,3 +114,320 @@ func (csp CommunityPoolEthereumSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.BridgeFee))
 	return b.String()
 }
+
+// NewSignerSetTxCreationProposal creates a new signer set tx creation proposal.
+func NewSignerSetTxCreationProposal(title, description string) *SignerSetTxCreationProposal {
+	return &SignerSetTxCreationProposal{title, description}
+}
+
+// GetTitle returns the title of a signer set tx creation proposal.
+func (sscp *SignerSetTxCreationProposal) GetTitle() string { return sscp.Title }
+
+// GetDescription returns the description of a signer set tx creation proposal.
+func (sscp *SignerSetTxCreationProposal) GetDescription() string { return sscp.Description }
+
+// ProposalRoute returns the routing key of a signer set tx creation proposal.
+func (sscp *SignerSetTxCreationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a signer set tx creation proposal.
+func (sscp *SignerSetTxCreationProposal) ProposalType() string {
+	return ProposalTypeSignerSetTxCreation
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (sscp *SignerSetTxCreationProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(sscp)
+}
+
+// String implements the Stringer interface.
+func (sscp SignerSetTxCreationProposal) String() string {
+	return fmt.Sprintf(`Signer Set Tx Creation Proposal:
+  Title:       %s
+  Description: %s
+`, sscp.Title, sscp.Description)
+}
+
+// NewRegisterCosmosDenomProposal creates a new register cosmos denom proposal.
+func NewRegisterCosmosDenomProposal(title, description, denom, erc20Name, erc20Symbol string, erc20Decimals uint64, decimalShift int32) *RegisterCosmosDenomProposal {
+	return &RegisterCosmosDenomProposal{title, description, denom, erc20Name, erc20Symbol, erc20Decimals, decimalShift}
+}
+
+// GetTitle returns the title of a register cosmos denom proposal.
+func (rcdp *RegisterCosmosDenomProposal) GetTitle() string { return rcdp.Title }
+
+// GetDescription returns the description of a register cosmos denom proposal.
+func (rcdp *RegisterCosmosDenomProposal) GetDescription() string { return rcdp.Description }
+
+// ProposalRoute returns the routing key of a register cosmos denom proposal.
+func (rcdp *RegisterCosmosDenomProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a register cosmos denom proposal.
+func (rcdp *RegisterCosmosDenomProposal) ProposalType() string {
+	return ProposalTypeRegisterCosmosDenom
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (rcdp *RegisterCosmosDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rcdp); err != nil {
+		return err
+	}
+
+	return rcdp.Registration().ValidateBasic()
+}
+
+// Registration returns the cosmos denom registration the proposal stores when it passes
+func (rcdp *RegisterCosmosDenomProposal) Registration() *CosmosDenomRegistration {
+	return &CosmosDenomRegistration{
+		Denom:         rcdp.Denom,
+		Erc20Name:     rcdp.Erc20Name,
+		Erc20Symbol:   rcdp.Erc20Symbol,
+		Erc20Decimals: rcdp.Erc20Decimals,
+		DecimalShift:  rcdp.DecimalShift,
+	}
+}
+
+// String implements the Stringer interface.
+func (rcdp RegisterCosmosDenomProposal) String() string {
+	return fmt.Sprintf(`Register Cosmos Denom Proposal:
+  Title:          %s
+  Description:    %s
+  Denom:          %s
+  ERC20 Name:     %s
+  ERC20 Symbol:   %s
+  ERC20 Decimals: %d
+  Decimal Shift:  %d
+`, rcdp.Title, rcdp.Description, rcdp.Denom, rcdp.Erc20Name, rcdp.Erc20Symbol, rcdp.Erc20Decimals, rcdp.DecimalShift)
+}
+
+// NewUpdateCosmosDenomERC20Proposal creates a new update cosmos denom ERC20 proposal.
+// An empty erc20 removes the mapping of the denom.
+func NewUpdateCosmosDenomERC20Proposal(title, description, denom, erc20 string, decimalShift int32) *UpdateCosmosDenomERC20Proposal {
+	return &UpdateCosmosDenomERC20Proposal{title, description, denom, erc20, decimalShift}
+}
+
+// GetTitle returns the title of an update cosmos denom ERC20 proposal.
+func (ucdp *UpdateCosmosDenomERC20Proposal) GetTitle() string { return ucdp.Title }
+
+// GetDescription returns the description of an update cosmos denom ERC20 proposal.
+func (ucdp *UpdateCosmosDenomERC20Proposal) GetDescription() string { return ucdp.Description }
+
+// ProposalRoute returns the routing key of an update cosmos denom ERC20 proposal.
+func (ucdp *UpdateCosmosDenomERC20Proposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update cosmos denom ERC20 proposal.
+func (ucdp *UpdateCosmosDenomERC20Proposal) ProposalType() string {
+	return ProposalTypeUpdateCosmosDenomERC20
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ucdp *UpdateCosmosDenomERC20Proposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ucdp); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(ucdp.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+
+	if ucdp.Erc20 != "" && !common.IsHexAddress(ucdp.Erc20) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid ERC20 address %s", ucdp.Erc20)
+	}
+
+	if ucdp.Erc20 == "" && ucdp.DecimalShift != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "decimal shift requires an ERC20")
+	}
+
+	return ValidateDecimalShift(ucdp.DecimalShift)
+}
+
+// String implements the Stringer interface.
+func (ucdp UpdateCosmosDenomERC20Proposal) String() string {
+	return fmt.Sprintf(`Update Cosmos Denom ERC20 Proposal:
+  Title:         %s
+  Description:   %s
+  Denom:         %s
+  ERC20:         %s
+  Decimal Shift: %d
+`, ucdp.Title, ucdp.Description, ucdp.Denom, ucdp.Erc20, ucdp.DecimalShift)
+}
+
+// NewCommunityPoolEthereumContractCallProposal creates a new community pool Ethereum contract call proposal.
+//nolint:interfacer
+func NewCommunityPoolEthereumContractCallProposal(title, description, address string, payload []byte, tokens, fees sdk.Coins) *CommunityPoolEthereumContractCallProposal {
+	return &CommunityPoolEthereumContractCallProposal{title, description, address, payload, tokens, fees}
+}
+
+// GetTitle returns the title of a community pool Ethereum contract call proposal.
+func (ccp *CommunityPoolEthereumContractCallProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a community pool Ethereum contract call proposal.
+func (ccp *CommunityPoolEthereumContractCallProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a community pool Ethereum contract call proposal.
+func (ccp *CommunityPoolEthereumContractCallProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool Ethereum contract call proposal.
+func (ccp *CommunityPoolEthereumContractCallProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolEthereumContractCall
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp *CommunityPoolEthereumContractCallProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ccp); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(ccp.Address) {
+		return ErrInvalidEthereumProposalRecipient
+	}
+
+	if ccp.Tokens.Empty() || !ccp.Tokens.IsValid() {
+		return ErrInvalidEthereumProposalAmount
+	}
+
+	if !ccp.Fees.IsValid() {
+		return ErrInvalidEthereumProposalBridgeFee
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ccp CommunityPoolEthereumContractCallProposal) String() string {
+	return fmt.Sprintf(`Community Pool Ethereum Contract Call Proposal:
+  Title:       %s
+  Description: %s
+  Address:     %s
+  Payload:     %s
+  Tokens:      %s
+  Fees:        %s
+`, ccp.Title, ccp.Description, ccp.Address, hex.EncodeToString(ccp.Payload), ccp.Tokens, ccp.Fees)
+}
+
+// NewCommunityPoolEthereumSpend creates a new spend of a community pool Ethereum multi spend proposal.
+func NewCommunityPoolEthereumSpend(recipient string, amount sdk.Coin, bridgeFee sdk.Coin) CommunityPoolEthereumSpend {
+	return CommunityPoolEthereumSpend{recipient, amount, bridgeFee}
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (s CommunityPoolEthereumSpend) ValidateBasic() error {
+	if !common.IsHexAddress(s.Recipient) {
+		return ErrInvalidEthereumProposalRecipient
+	}
+
+	if !s.Amount.IsValid() || s.Amount.IsZero() {
+		return ErrInvalidEthereumProposalAmount
+	}
+
+	if !s.BridgeFee.IsValid() {
+		return ErrInvalidEthereumProposalBridgeFee
+	}
+
+	if s.Amount.Denom != s.BridgeFee.Denom {
+		return ErrEthereumProposalDenomMismatch
+	}
+
+	return nil
+}
+
+// NewCommunityPoolEthereumMultiSpendProposal creates a new community pool Ethereum multi spend proposal.
+func NewCommunityPoolEthereumMultiSpendProposal(title, description string, spends []CommunityPoolEthereumSpend) *CommunityPoolEthereumMultiSpendProposal {
+	return &CommunityPoolEthereumMultiSpendProposal{title, description, spends}
+}
+
+// GetTitle returns the title of a community pool Ethereum multi spend proposal.
+func (msp *CommunityPoolEthereumMultiSpendProposal) GetTitle() string { return msp.Title }
+
+// GetDescription returns the description of a community pool Ethereum multi spend proposal.
+func (msp *CommunityPoolEthereumMultiSpendProposal) GetDescription() string { return msp.Description }
+
+// ProposalRoute returns the routing key of a community pool Ethereum multi spend proposal.
+func (msp *CommunityPoolEthereumMultiSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool Ethereum multi spend proposal.
+func (msp *CommunityPoolEthereumMultiSpendProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolEthereumMultiSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (msp *CommunityPoolEthereumMultiSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(msp); err != nil {
+		return err
+	}
+
+	if len(msp.Spends) == 0 {
+		return sdkerrors.Wrap(ErrInvalidEthereumProposalAmount, "no spends")
+	}
+
+	for i, spend := range msp.Spends {
+		if err := spend.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "spend %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Total returns the sum of the amounts and bridge fees of all spends.
+func (msp *CommunityPoolEthereumMultiSpendProposal) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, spend := range msp.Spends {
+		total = total.Add(spend.Amount).Add(spend.BridgeFee)
+	}
+	return total
+}
+
+// String implements the Stringer interface.
+func (msp CommunityPoolEthereumMultiSpendProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Ethereum Multi Spend Proposal:
+  Title:       %s
+  Description: %s
+  Spends:
+`, msp.Title, msp.Description))
+	for _, spend := range msp.Spends {
+		b.WriteString(fmt.Sprintf("    %s to %s, bridge fee %s\n", spend.Amount, spend.Recipient, spend.BridgeFee))
+	}
+	return b.String()
+}
+
+// NewCommunityPoolContractCallRefundProposal creates a new community pool contract call refund proposal.
+func NewCommunityPoolContractCallRefundProposal(title, description string, invalidationNonce uint64) *CommunityPoolContractCallRefundProposal {
+	return &CommunityPoolContractCallRefundProposal{title, description, invalidationNonce}
+}
+
+// GetTitle returns the title of a community pool contract call refund proposal.
+func (crp *CommunityPoolContractCallRefundProposal) GetTitle() string { return crp.Title }
+
+// GetDescription returns the description of a community pool contract call refund proposal.
+func (crp *CommunityPoolContractCallRefundProposal) GetDescription() string { return crp.Description }
+
+// ProposalRoute returns the routing key of a community pool contract call refund proposal.
+func (crp *CommunityPoolContractCallRefundProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool contract call refund proposal.
+func (crp *CommunityPoolContractCallRefundProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolContractCallRefund
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (crp *CommunityPoolContractCallRefundProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(crp); err != nil {
+		return err
+	}
+
+	if crp.InvalidationNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "invalidation nonce cannot be 0")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (crp CommunityPoolContractCallRefundProposal) String() string {
+	return fmt.Sprintf(`Community Pool Contract Call Refund Proposal:
+  Title:              %s
+  Description:        %s
+  Invalidation Nonce: %d
+`, crp.Title, crp.Description, crp.InvalidationNonce)
+}
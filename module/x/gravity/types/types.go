@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"math"
+	"math/big"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +24,11 @@ func (b *EthereumSigner) ValidateBasic() error {
 	return nil
 }
 
+// EthereumSignerSetPowerThreshold is the power threshold the Gravity contract
+// is deployed with, 66% of the uint32 max normalized signer set power. The
+// contract only accepts a tx once the signatures over it exceed this power.
+const EthereumSignerSetPowerThreshold uint64 = 2834678415
+
 // EthereumSigners is the sorted set of validator data for Ethereum bridge MultiSig set
 type EthereumSigners []*EthereumSigner
 
@@ -48,6 +54,61 @@ func (b EthereumSigners) Hash() []byte {
 	return hash[:]
 }
 
+// CapPowers limits the share of the total power that any single signer holds
+// to maxShare, redistributing the excess proportionally among the signers
+// below the cap. Since redistribution can push other signers over the cap,
+// this is repeated until no signer exceeds it. If maxShare is too small for
+// the number of signers to add up to the total, every signer ends up with an
+// equal share. A zero maxShare leaves the powers untouched.
+//
+// Integer division is used throughout, so the total power after capping can
+// be lower than before by at most one unit per signer.
+func (b EthereumSigners) CapPowers(maxShare sdk.Dec) {
+	if maxShare.IsNil() || !maxShare.IsPositive() || len(b) == 0 {
+		return
+	}
+
+	total := b.TotalPower()
+	capPower := maxShare.MulInt(sdk.NewIntFromUint64(total)).TruncateInt().Uint64()
+	if capPower*uint64(len(b)) < total {
+		capPower = total / uint64(len(b))
+	}
+
+	original := b.GetPowers()
+	capped := make([]bool, len(b))
+	for {
+		remaining := total
+		var uncappedPower uint64
+		for i := range b {
+			if capped[i] {
+				remaining -= capPower
+			} else {
+				uncappedPower += original[i]
+			}
+		}
+
+		exceeded := false
+		for i := range b {
+			switch {
+			case capped[i]:
+				b[i].Power = capPower
+			case uncappedPower == 0:
+				b[i].Power = 0
+			default:
+				b[i].Power = sdk.NewUint(original[i]).MulUint64(remaining).QuoUint64(uncappedPower).Uint64()
+				if b[i].Power > capPower {
+					capped[i] = true
+					exceeded = true
+				}
+			}
+		}
+
+		if !exceeded {
+			return
+		}
+	}
+}
+
 // PowerDiff returns the difference in power between two bridge validator sets
 // note this is Gravity bridge power *not* Cosmos voting power. Cosmos voting
 // power is based on the absolute number of tokens in the staking pool at any given
@@ -62,6 +123,9 @@ func (b EthereumSigners) Hash() []byte {
 // if the total on chain voting power increases by 1% due to inflation, we shouldn't have to generate a new validator
 // set, after all the validators retained their relative percentages during inflation and normalized Gravity bridge power
 // shows no difference.
+//
+// Both sets are expected to have the same per-signer power cap applied, see CapPowers, as is the case for
+// the current signer set and the signer set txs created from it.
 func (b EthereumSigners) PowerDiff(c EthereumSigners) float64 {
 	// loop over b and initialize the map with their powers
 	powers := map[string]int64{}
@@ -103,6 +167,16 @@ func (b EthereumSigners) TotalPower() (out uint64) {
 	return
 }
 
+// Contains returns whether the given Ethereum address is a member
+func (b EthereumSigners) Contains(ethAddr common.Address) bool {
+	for _, v := range b {
+		if common.HexToAddress(v.EthereumAddress) == ethAddr {
+			return true
+		}
+	}
+	return false
+}
+
 // GetPowers returns only the power values for all members
 func (b EthereumSigners) GetPowers() []uint64 {
 	r := make([]uint64, len(b))
@@ -130,3 +204,113 @@ func (b BatchTx) GetFees() sdk.Int {
 	}
 	return sum
 }
+
+// ValidateBasic performs stateless checks on validity
+func (r *CosmosDenomRegistration) ValidateBasic() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if _, err := GravityDenomToERC20(r.Denom); err == nil {
+		return sdkerrors.Wrapf(ErrInvalid, "%s is an Ethereum originated denom", r.Denom)
+	}
+	if r.Erc20Name == "" && (r.Erc20Symbol != "" || r.Erc20Decimals != 0) {
+		return sdkerrors.Wrap(ErrInvalid, "expected ERC20 symbol and decimals require an expected ERC20 name")
+	}
+	if err := ValidateDecimalShift(r.DecimalShift); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on an ERC20 to denom relation
+func (e *ERC20ToDenom) ValidateBasic() error {
+	if !common.IsHexAddress(e.Erc20) {
+		return sdkerrors.Wrapf(ErrInvalid, "erc20 %s is not a valid ethereum address", e.Erc20)
+	}
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return ValidateDecimalShift(e.DecimalShift)
+}
+
+// ValidateBasic performs stateless checks on a rotated Ethereum address
+func (r *RotatedEthereumAddress) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "validator address")
+	}
+	if !common.IsHexAddress(r.EthereumAddress) {
+		return sdkerrors.Wrapf(ErrInvalid, "%s is not a valid ethereum address", r.EthereumAddress)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on an executed outgoing tx record
+func (r *ExecutedOutgoingTx) ValidateBasic() error {
+	if len(r.StoreIndex) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "empty store index")
+	}
+	for _, token := range r.TokenContracts {
+		if !common.IsHexAddress(token) {
+			return sdkerrors.Wrapf(ErrInvalid, "token contract %s is not a valid ethereum address", token)
+		}
+	}
+	for _, sender := range r.Senders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sender)
+		}
+	}
+	return nil
+}
+
+// MaxDecimalShift bounds the number of decimals a Cosmos originated denom and
+// its ERC20 may differ by
+const MaxDecimalShift = 18
+
+// ValidateDecimalShift checks the decimal shift of a denom to ERC20 mapping is within bounds
+func ValidateDecimalShift(decimalShift int32) error {
+	if decimalShift > MaxDecimalShift || decimalShift < -MaxDecimalShift {
+		return sdkerrors.Wrapf(ErrInvalid, "decimal shift %d exceeds the maximum of %d", decimalShift, MaxDecimalShift)
+	}
+	return nil
+}
+
+// ToERC20Amount converts an amount of a Cosmos originated denom to the amount
+// of its ERC20, given the decimal shift of their mapping. When the ERC20 has
+// fewer decimals than the denom, the remainder it can't represent is returned
+// as dust, in the denom's units.
+func ToERC20Amount(amount sdk.Int, decimalShift int32) (erc20Amount sdk.Int, dust sdk.Int, err error) {
+	return shiftDecimals(amount, decimalShift)
+}
+
+// FromERC20Amount converts an amount of an ERC20 to the amount of the Cosmos
+// originated denom it is mapped to, given the decimal shift of their mapping.
+// When the ERC20 has more decimals than the denom, the remainder the denom
+// can't represent is returned as dust, in the ERC20's units.
+func FromERC20Amount(erc20Amount sdk.Int, decimalShift int32) (amount sdk.Int, dust sdk.Int, err error) {
+	return shiftDecimals(erc20Amount, -decimalShift)
+}
+
+// shiftDecimals multiplies amount by 10^shift, truncating towards zero and
+// returning the truncated remainder when shift is negative
+func shiftDecimals(amount sdk.Int, shift int32) (sdk.Int, sdk.Int, error) {
+	if shift == 0 {
+		return amount, sdk.ZeroInt(), nil
+	}
+
+	abs := int64(shift)
+	if abs < 0 {
+		abs = -abs
+	}
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(abs), nil)
+
+	if shift < 0 {
+		quo, rem := new(big.Int).QuoRem(amount.BigInt(), factor, new(big.Int))
+		return sdk.NewIntFromBigInt(quo), sdk.NewIntFromBigInt(rem), nil
+	}
+
+	scaled := new(big.Int).Mul(amount.BigInt(), factor)
+	if scaled.BitLen() > 256 {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(ErrInvalid, "amount %s overflows when shifted by %d decimals", amount, shift)
+	}
+	return sdk.NewIntFromBigInt(scaled), sdk.ZeroInt(), nil
+}
@@ -3,9 +3,12 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
+	"math/big"
 	mrand "math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
@@ -96,6 +99,91 @@ func TestEthereumSigners_PowerDiff(t *testing.T) {
 	}
 }
 
+func TestEthereumSigners_CapPowers(t *testing.T) {
+	specs := map[string]struct {
+		powers   []uint64
+		maxShare sdk.Dec
+		exp      []uint64
+	}{
+		"disabled": {
+			powers:   []uint64{3006477107, 858993459, 429496729},
+			maxShare: sdk.ZeroDec(),
+			exp:      []uint64{3006477107, 858993459, 429496729},
+		},
+		"no signer above cap": {
+			powers:   []uint64{1431655765, 1431655765, 1431655765},
+			maxShare: sdk.NewDecWithPrec(5, 1),
+			exp:      []uint64{1431655765, 1431655765, 1431655765},
+		},
+		"excess redistributed proportionally": {
+			powers:   []uint64{3006477107, 858993459, 429496729},
+			maxShare: sdk.NewDecWithPrec(5, 1),
+			exp:      []uint64{2147483647, 1431655765, 715827882},
+		},
+		"redistribution pushes another signer over the cap": {
+			powers:   []uint64{2147483647, 1288490188, 429496729, 429496729},
+			maxShare: sdk.NewDecWithPrec(3, 1),
+			exp:      []uint64{1288490187, 1288490187, 858993459, 858993459},
+		},
+		"cap too small for the number of signers": {
+			powers:   []uint64{3006477107, 858993459, 429496729},
+			maxShare: sdk.NewDecWithPrec(1, 1),
+			exp:      []uint64{1431655765, 1431655765, 1431655765},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			signers := make(EthereumSigners, len(spec.powers))
+			for i, p := range spec.powers {
+				signers[i] = &EthereumSigner{Power: p, EthereumAddress: gethcommon.BytesToAddress([]byte{byte(i + 1)}).Hex()}
+			}
+			signers.CapPowers(spec.maxShare)
+			assert.Equal(t, spec.exp, signers.GetPowers())
+		})
+	}
+}
+
+func TestEthereumSigners_CapPowersRoundingBounds(t *testing.T) {
+	r := mrand.New(mrand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		n := r.Intn(150) + 1
+		powers := make([]uint64, n)
+		var total uint64
+		for j := range powers {
+			powers[j] = uint64(r.Int63n(1_000_000_000) + 1)
+			total += powers[j]
+		}
+
+		// normalize the same way the keeper does for the current signer set
+		signers := make(EthereumSigners, n)
+		for j := range powers {
+			signers[j] = &EthereumSigner{
+				Power:           sdk.NewUint(powers[j]).MulUint64(math.MaxUint32).QuoUint64(total).Uint64(),
+				EthereumAddress: gethcommon.BytesToAddress([]byte{byte(j), byte(j >> 8)}).Hex(),
+			}
+		}
+		normalizedTotal := signers.TotalPower()
+		assert.LessOrEqual(t, normalizedTotal, uint64(math.MaxUint32))
+		assert.GreaterOrEqual(t, normalizedTotal, uint64(math.MaxUint32)-uint64(n))
+
+		maxShare := sdk.NewDecWithPrec(r.Int63n(100)+1, 2)
+		signers.CapPowers(maxShare)
+
+		// capping never adds power and loses at most one unit per signer to truncation
+		cappedTotal := signers.TotalPower()
+		assert.LessOrEqual(t, cappedTotal, normalizedTotal)
+		assert.GreaterOrEqual(t, cappedTotal, normalizedTotal-uint64(n))
+
+		capPower := maxShare.MulInt(sdk.NewIntFromUint64(normalizedTotal)).TruncateInt().Uint64()
+		if capPower*uint64(n) < normalizedTotal {
+			capPower = normalizedTotal / uint64(n)
+		}
+		for _, s := range signers {
+			assert.LessOrEqual(t, s.Power, capPower)
+		}
+	}
+}
+
 func TestValsetSort(t *testing.T) {
 	specs := map[string]struct {
 		src EthereumSigners
@@ -168,3 +256,88 @@ func shuffled(v EthereumSigners) EthereumSigners {
 	})
 	return v
 }
+
+func TestDecimalShiftRounding(t *testing.T) {
+	specs := map[string]struct {
+		amount       int64
+		decimalShift int32
+		expERC20     int64
+		expDust      int64
+		expBack      int64
+		expBackDust  int64
+	}{
+		"no shift": {
+			amount: 12345, decimalShift: 0,
+			expERC20: 12345, expDust: 0, expBack: 12345, expBackDust: 0,
+		},
+		"more ERC20 decimals scale up exactly": {
+			amount: 12345, decimalShift: 3,
+			expERC20: 12345000, expDust: 0, expBack: 12345, expBackDust: 0,
+		},
+		"fewer ERC20 decimals truncate dust": {
+			amount: 12345, decimalShift: -3,
+			expERC20: 12, expDust: 345, expBack: 12000, expBackDust: 0,
+		},
+		"less than one ERC20 unit is all dust": {
+			amount: 999, decimalShift: -3,
+			expERC20: 0, expDust: 999, expBack: 0, expBackDust: 0,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			erc20Amount, dust, err := ToERC20Amount(sdk.NewInt(spec.amount), spec.decimalShift)
+			assert.NoError(t, err)
+			assert.Equal(t, spec.expERC20, erc20Amount.Int64())
+			assert.Equal(t, spec.expDust, dust.Int64())
+
+			amount, dust, err := FromERC20Amount(erc20Amount, spec.decimalShift)
+			assert.NoError(t, err)
+			assert.Equal(t, spec.expBack, amount.Int64())
+			assert.Equal(t, spec.expBackDust, dust.Int64())
+		})
+	}
+
+	// ERC20 amounts the denom can't fully represent leave dust on Ethereum
+	amount, dust, err := FromERC20Amount(sdk.NewInt(1234567), 3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1234, amount.Int64())
+	assert.EqualValues(t, 567, dust.Int64())
+
+	// scaled amounts must still fit in a uint256
+	max := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	_, _, err = ToERC20Amount(max, 1)
+	assert.Error(t, err)
+	_, _, err = FromERC20Amount(max, -1)
+	assert.Error(t, err)
+
+	assert.NoError(t, ValidateDecimalShift(-MaxDecimalShift))
+	assert.Error(t, ValidateDecimalShift(MaxDecimalShift+1))
+}
+
+func TestUpdateCosmosDenomERC20ProposalValidateBasic(t *testing.T) {
+	erc20 := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+
+	assert.NoError(t, NewUpdateCosmosDenomERC20Proposal("title", "description", "uatom", erc20, 6).ValidateBasic())
+	assert.NoError(t, NewUpdateCosmosDenomERC20Proposal("title", "description", "uatom", "", 0).ValidateBasic())
+	assert.Error(t, NewUpdateCosmosDenomERC20Proposal("title", "description", "uatom", "", 6).ValidateBasic(), "decimal shift without an ERC20")
+	assert.Error(t, NewUpdateCosmosDenomERC20Proposal("title", "description", "uatom", erc20, MaxDecimalShift+1).ValidateBasic())
+	assert.Error(t, NewUpdateCosmosDenomERC20Proposal("title", "description", "uatom", "0xdeadbeef", 0).ValidateBasic())
+}
+
+func TestSendToCosmosEventHashMetadata(t *testing.T) {
+	event := func(name, symbol string) *SendToCosmosEvent {
+		return &SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Amount:         sdk.NewInt(1),
+			EthereumSender: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			CosmosReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+			EthereumHeight: 1,
+			Erc20Metadata:  &ERC20Metadata{Name: name, Symbol: symbol, Decimals: 18},
+		}
+	}
+
+	// moving bytes between the name and symbol must change the hash
+	assert.NotEqual(t, event("ab", "c").Hash(), event("a", "bc").Hash())
+	assert.Equal(t, event("ab", "c").Hash(), event("ab", "c").Hash())
+}